@@ -0,0 +1,51 @@
+package client
+
+import (
+	"net/http"
+
+	httptransport "github.com/go-openapi/runtime/client"
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// Credentials authenticate a client to a server that requires them.
+type Credentials struct {
+	// Token is sent as a bearer token.
+	Token string
+	// CertFile and KeyFile are a client certificate for mutual TLS.
+	CertFile string
+	KeyFile  string
+	// CAFile verifies the certificate of the server.
+	CAFile string
+}
+
+// secure reports whether the server is reached with https.
+func (c *Credentials) secure() bool {
+	return len(c.CertFile) > 0 || len(c.CAFile) > 0
+}
+
+// NewHTTPClientWithCredentials creates a client for a host that
+// authenticates with creds. It uses https when a certificate or CA is given.
+func NewHTTPClientWithCredentials(formats strfmt.Registry, host string, creds *Credentials) (*AnApplicationForEasierDistributedApplicationGeneration, error) {
+	if formats == nil {
+		formats = strfmt.Default
+	}
+	schemes := DefaultSchemes
+	httpClient := http.DefaultClient
+	if creds.secure() {
+		schemes = []string{"https"}
+		var err error
+		httpClient, err = httptransport.TLSClient(httptransport.TLSClientOptions{
+			Certificate: creds.CertFile,
+			Key:         creds.KeyFile,
+			CA:          creds.CAFile,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	transport := httptransport.NewWithClient(host, DefaultBasePath, schemes, httpClient)
+	if len(creds.Token) > 0 {
+		transport.DefaultAuthentication = httptransport.BearerToken(creds.Token)
+	}
+	return New(transport, formats), nil
+}
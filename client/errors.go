@@ -0,0 +1,28 @@
+package client
+
+import (
+	"github.com/metaparticle-io/metaparticle-ast/client/services"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+)
+
+// UpdateError returns the message the server sent for a failed update, or
+// the error itself when the server sent none.
+func UpdateError(err error) string {
+	var payload *models.Error
+	switch e := err.(type) {
+	case *services.CreateOrUpdateServiceBadRequest:
+		payload = e.Payload
+	case *services.CreateOrUpdateServiceUnprocessableEntity:
+		payload = e.Payload
+	case *services.CreateOrUpdateServiceConflict:
+		payload = e.Payload
+	case *services.CreateOrUpdateServicePreconditionFailed:
+		payload = e.Payload
+	case *services.CreateOrUpdateServiceDefault:
+		payload = e.Payload
+	}
+	if payload == nil || payload.Message == nil {
+		return err.Error()
+	}
+	return *payload.Message
+}
@@ -0,0 +1,36 @@
+package client
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/metaparticle-io/metaparticle-ast/client/services"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+)
+
+func TestUpdateError(t *testing.T) {
+	payload := &models.Error{Code: 422, Message: swag.String("web: ports[0]: an entry is required")}
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{name: "unprocessable", err: &services.CreateOrUpdateServiceUnprocessableEntity{Payload: payload}, expected: *payload.Message},
+		{name: "precondition failed", err: &services.CreateOrUpdateServicePreconditionFailed{Payload: payload}, expected: *payload.Message},
+		{name: "default", err: &services.CreateOrUpdateServiceDefault{Payload: payload}, expected: *payload.Message},
+		{name: "no message", err: &services.CreateOrUpdateServiceConflict{Payload: &models.Error{Code: 409}}},
+		{name: "other error", err: fmt.Errorf("connection refused"), expected: "connection refused"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expected := test.expected
+			if len(expected) == 0 {
+				expected = test.err.Error()
+			}
+			if message := UpdateError(test.err); message != expected {
+				t.Errorf("expected %q, got %q", expected, message)
+			}
+		})
+	}
+}
@@ -64,10 +64,25 @@ for the create or update service operation typically these are written to a http
 */
 type CreateOrUpdateServiceParams struct {
 
+	/*Author
+	  who made the change, kept in the revision history
+
+	*/
+	Author *string
 	/*Body*/
 	Body *models.Service
+	/*IfMatch
+	  only update the service if it still has one of these ETags
+
+	*/
+	IfMatch *string
 	/*Name*/
 	Name string
+	/*Summary
+	  what the change does, kept in the revision history
+
+	*/
+	Summary *string
 
 	timeout    time.Duration
 	Context    context.Context
@@ -107,6 +122,17 @@ func (o *CreateOrUpdateServiceParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithAuthor adds the author to the create or update service params
+func (o *CreateOrUpdateServiceParams) WithAuthor(author *string) *CreateOrUpdateServiceParams {
+	o.SetAuthor(author)
+	return o
+}
+
+// SetAuthor adds the author to the create or update service params
+func (o *CreateOrUpdateServiceParams) SetAuthor(author *string) {
+	o.Author = author
+}
+
 // WithBody adds the body to the create or update service params
 func (o *CreateOrUpdateServiceParams) WithBody(body *models.Service) *CreateOrUpdateServiceParams {
 	o.SetBody(body)
@@ -118,6 +144,17 @@ func (o *CreateOrUpdateServiceParams) SetBody(body *models.Service) {
 	o.Body = body
 }
 
+// WithIfMatch adds the ifMatch to the create or update service params
+func (o *CreateOrUpdateServiceParams) WithIfMatch(ifMatch *string) *CreateOrUpdateServiceParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the create or update service params
+func (o *CreateOrUpdateServiceParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WithName adds the name to the create or update service params
 func (o *CreateOrUpdateServiceParams) WithName(name string) *CreateOrUpdateServiceParams {
 	o.SetName(name)
@@ -129,6 +166,17 @@ func (o *CreateOrUpdateServiceParams) SetName(name string) {
 	o.Name = name
 }
 
+// WithSummary adds the summary to the create or update service params
+func (o *CreateOrUpdateServiceParams) WithSummary(summary *string) *CreateOrUpdateServiceParams {
+	o.SetSummary(summary)
+	return o
+}
+
+// SetSummary adds the summary to the create or update service params
+func (o *CreateOrUpdateServiceParams) SetSummary(summary *string) {
+	o.Summary = summary
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *CreateOrUpdateServiceParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -137,17 +185,58 @@ func (o *CreateOrUpdateServiceParams) WriteToRequest(r runtime.ClientRequest, re
 	}
 	var res []error
 
+	if o.Author != nil {
+
+		// query param author
+		var qrAuthor string
+		if o.Author != nil {
+			qrAuthor = *o.Author
+		}
+		qAuthor := qrAuthor
+		if qAuthor != "" {
+			if err := r.SetQueryParam("author", qAuthor); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if o.Body != nil {
 		if err := r.SetBodyParam(o.Body); err != nil {
 			return err
 		}
 	}
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+
+	}
+
 	// path param name
 	if err := r.SetPathParam("name", o.Name); err != nil {
 		return err
 	}
 
+	if o.Summary != nil {
+
+		// query param summary
+		var qrSummary string
+		if o.Summary != nil {
+			qrSummary = *o.Summary
+		}
+		qSummary := qrSummary
+		if qSummary != "" {
+			if err := r.SetQueryParam("summary", qSummary); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -32,6 +32,34 @@ func (o *CreateOrUpdateServiceReader) ReadResponse(response runtime.ClientRespon
 		}
 		return result, nil
 
+	case 400:
+		result := NewCreateOrUpdateServiceBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 409:
+		result := NewCreateOrUpdateServiceConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 412:
+		result := NewCreateOrUpdateServicePreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 422:
+		result := NewCreateOrUpdateServiceUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
 	default:
 		result := NewCreateOrUpdateServiceDefault(response.Code())
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -54,6 +82,10 @@ func NewCreateOrUpdateServiceOK() *CreateOrUpdateServiceOK {
 OK
 */
 type CreateOrUpdateServiceOK struct {
+	/*the new resource version of the service
+	 */
+	ETag string
+
 	Payload *models.Service
 }
 
@@ -63,6 +95,9 @@ func (o *CreateOrUpdateServiceOK) Error() string {
 
 func (o *CreateOrUpdateServiceOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// response header ETag
+	o.ETag = response.GetHeader("ETag")
+
 	o.Payload = new(models.Service)
 
 	// response payload
@@ -73,6 +108,122 @@ func (o *CreateOrUpdateServiceOK) readResponse(response runtime.ClientResponse,
 	return nil
 }
 
+// NewCreateOrUpdateServiceBadRequest creates a CreateOrUpdateServiceBadRequest with default headers values
+func NewCreateOrUpdateServiceBadRequest() *CreateOrUpdateServiceBadRequest {
+	return &CreateOrUpdateServiceBadRequest{}
+}
+
+/*CreateOrUpdateServiceBadRequest handles this case with default header values.
+
+the body is missing or doesn't match the path
+*/
+type CreateOrUpdateServiceBadRequest struct {
+	Payload *models.Error
+}
+
+func (o *CreateOrUpdateServiceBadRequest) Error() string {
+	return fmt.Sprintf("[PUT /services/{name}][%d] createOrUpdateServiceBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CreateOrUpdateServiceBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateOrUpdateServiceConflict creates a CreateOrUpdateServiceConflict with default headers values
+func NewCreateOrUpdateServiceConflict() *CreateOrUpdateServiceConflict {
+	return &CreateOrUpdateServiceConflict{}
+}
+
+/*CreateOrUpdateServiceConflict handles this case with default header values.
+
+the resource version of the body isn't the stored one
+*/
+type CreateOrUpdateServiceConflict struct {
+	Payload *models.Error
+}
+
+func (o *CreateOrUpdateServiceConflict) Error() string {
+	return fmt.Sprintf("[PUT /services/{name}][%d] createOrUpdateServiceConflict  %+v", 409, o.Payload)
+}
+
+func (o *CreateOrUpdateServiceConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateOrUpdateServicePreconditionFailed creates a CreateOrUpdateServicePreconditionFailed with default headers values
+func NewCreateOrUpdateServicePreconditionFailed() *CreateOrUpdateServicePreconditionFailed {
+	return &CreateOrUpdateServicePreconditionFailed{}
+}
+
+/*CreateOrUpdateServicePreconditionFailed handles this case with default header values.
+
+If-Match doesn't match the stored service
+*/
+type CreateOrUpdateServicePreconditionFailed struct {
+	Payload *models.Error
+}
+
+func (o *CreateOrUpdateServicePreconditionFailed) Error() string {
+	return fmt.Sprintf("[PUT /services/{name}][%d] createOrUpdateServicePreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *CreateOrUpdateServicePreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateOrUpdateServiceUnprocessableEntity creates a CreateOrUpdateServiceUnprocessableEntity with default headers values
+func NewCreateOrUpdateServiceUnprocessableEntity() *CreateOrUpdateServiceUnprocessableEntity {
+	return &CreateOrUpdateServiceUnprocessableEntity{}
+}
+
+/*CreateOrUpdateServiceUnprocessableEntity handles this case with default header values.
+
+the service is invalid
+*/
+type CreateOrUpdateServiceUnprocessableEntity struct {
+	Payload *models.Error
+}
+
+func (o *CreateOrUpdateServiceUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[PUT /services/{name}][%d] createOrUpdateServiceUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *CreateOrUpdateServiceUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewCreateOrUpdateServiceDefault creates a CreateOrUpdateServiceDefault with default headers values
 func NewCreateOrUpdateServiceDefault(code int) *CreateOrUpdateServiceDefault {
 	return &CreateOrUpdateServiceDefault{
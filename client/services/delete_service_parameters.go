@@ -62,6 +62,11 @@ for the delete service operation typically these are written to a http.Request
 */
 type DeleteServiceParams struct {
 
+	/*IfMatch
+	  only delete the service if it still has one of these ETags
+
+	*/
+	IfMatch *string
 	/*Name*/
 	Name string
 
@@ -103,6 +108,17 @@ func (o *DeleteServiceParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIfMatch adds the ifMatch to the delete service params
+func (o *DeleteServiceParams) WithIfMatch(ifMatch *string) *DeleteServiceParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the delete service params
+func (o *DeleteServiceParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WithName adds the name to the delete service params
 func (o *DeleteServiceParams) WithName(name string) *DeleteServiceParams {
 	o.SetName(name)
@@ -122,6 +138,15 @@ func (o *DeleteServiceParams) WriteToRequest(r runtime.ClientRequest, reg strfmt
 	}
 	var res []error
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+
+	}
+
 	// path param name
 	if err := r.SetPathParam("name", o.Name); err != nil {
 		return err
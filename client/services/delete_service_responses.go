@@ -32,6 +32,13 @@ func (o *DeleteServiceReader) ReadResponse(response runtime.ClientResponse, cons
 		}
 		return result, nil
 
+	case 412:
+		result := NewDeleteServicePreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
 	default:
 		result := NewDeleteServiceDefault(response.Code())
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -65,6 +72,35 @@ func (o *DeleteServiceNoContent) readResponse(response runtime.ClientResponse, c
 	return nil
 }
 
+// NewDeleteServicePreconditionFailed creates a DeleteServicePreconditionFailed with default headers values
+func NewDeleteServicePreconditionFailed() *DeleteServicePreconditionFailed {
+	return &DeleteServicePreconditionFailed{}
+}
+
+/*DeleteServicePreconditionFailed handles this case with default header values.
+
+If-Match doesn't match the stored service
+*/
+type DeleteServicePreconditionFailed struct {
+	Payload *models.Error
+}
+
+func (o *DeleteServicePreconditionFailed) Error() string {
+	return fmt.Sprintf("[DELETE /services/{name}][%d] deleteServicePreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *DeleteServicePreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewDeleteServiceDefault creates a DeleteServiceDefault with default headers values
 func NewDeleteServiceDefault(code int) *DeleteServiceDefault {
 	return &DeleteServiceDefault{
@@ -0,0 +1,84 @@
+package services
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/metaparticle-io/metaparticle-ast/models"
+)
+
+/*
+StreamServiceLogs reads the logs of a service as they are streamed, passing
+every event to handle. It returns when the stream ends, when the server
+reports a failure or when handle returns an error.
+*/
+func (a *Client) StreamServiceLogs(params *GetServiceLogsParams, handle func(*models.LogEvent) error) error {
+	if params == nil {
+		params = NewGetServiceLogsParams()
+	}
+
+	_, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:          "getServiceLogs",
+		Method:      "GET",
+		PathPattern: "/services/{name}/logs",
+		// newline delimited JSON, which the JSON consumer reads for failures
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader: runtime.ClientResponseReaderFunc(func(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+			if response.Code() != 200 {
+				reader := &GetServiceLogsReader{formats: a.formats}
+				return reader.ReadResponse(response, consumer)
+			}
+			return nil, readLogEvents(response.Body(), a.formats, handle)
+		}),
+		Context: params.Context,
+		Client:  params.HTTPClient,
+	})
+	return err
+}
+
+// readLogEvents decodes the objects of a log stream until it ends. An error
+// object ends the stream with the failure of the server.
+func readLogEvents(body io.Reader, formats strfmt.Registry, handle func(*models.LogEvent) error) error {
+	decoder := json.NewDecoder(body)
+	for {
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		// only errors have a code
+		var kind struct {
+			Code *int64 `json:"code"`
+		}
+		if err := json.Unmarshal(raw, &kind); err != nil {
+			return err
+		}
+		if kind.Code != nil {
+			result := NewGetServiceLogsDefault(int(*kind.Code))
+			result.Payload = new(models.Error)
+			if err := json.Unmarshal(raw, result.Payload); err != nil {
+				return err
+			}
+			return result
+		}
+
+		event := &models.LogEvent{}
+		if err := json.Unmarshal(raw, event); err != nil {
+			return err
+		}
+		if err := event.Validate(formats); err != nil {
+			return err
+		}
+		if err := handle(event); err != nil {
+			return err
+		}
+	}
+}
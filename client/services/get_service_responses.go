@@ -54,6 +54,10 @@ func NewGetServiceOK() *GetServiceOK {
 OK
 */
 type GetServiceOK struct {
+	/*the resource version of the service
+	 */
+	ETag string
+
 	Payload *models.Service
 }
 
@@ -63,6 +67,9 @@ func (o *GetServiceOK) Error() string {
 
 func (o *GetServiceOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// response header ETag
+	o.ETag = response.GetHeader("ETag")
+
 	o.Payload = new(models.Service)
 
 	// response payload
@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
 
 	strfmt "github.com/go-openapi/strfmt"
 )
@@ -21,7 +22,7 @@ import (
 // NewListServicesParams creates a new ListServicesParams object
 // with the default values initialized.
 func NewListServicesParams() *ListServicesParams {
-
+	var ()
 	return &ListServicesParams{
 
 		timeout: cr.DefaultTimeout,
@@ -31,7 +32,7 @@ func NewListServicesParams() *ListServicesParams {
 // NewListServicesParamsWithTimeout creates a new ListServicesParams object
 // with the default values initialized, and the ability to set a timeout on a request
 func NewListServicesParamsWithTimeout(timeout time.Duration) *ListServicesParams {
-
+	var ()
 	return &ListServicesParams{
 
 		timeout: timeout,
@@ -41,7 +42,7 @@ func NewListServicesParamsWithTimeout(timeout time.Duration) *ListServicesParams
 // NewListServicesParamsWithContext creates a new ListServicesParams object
 // with the default values initialized, and the ability to set a context for a request
 func NewListServicesParamsWithContext(ctx context.Context) *ListServicesParams {
-
+	var ()
 	return &ListServicesParams{
 
 		Context: ctx,
@@ -51,7 +52,7 @@ func NewListServicesParamsWithContext(ctx context.Context) *ListServicesParams {
 // NewListServicesParamsWithHTTPClient creates a new ListServicesParams object
 // with the default values initialized, and the ability to set a custom HTTPClient for a request
 func NewListServicesParamsWithHTTPClient(client *http.Client) *ListServicesParams {
-
+	var ()
 	return &ListServicesParams{
 		HTTPClient: client,
 	}
@@ -61,6 +62,48 @@ func NewListServicesParamsWithHTTPClient(client *http.Client) *ListServicesParam
 for the list services operation typically these are written to a http.Request
 */
 type ListServicesParams struct {
+
+	/*Backend
+	  only the services deployed to this backend
+
+	*/
+	Backend *string
+	/*Continue
+	  the token of the previous page, from its X-Continue header
+
+	*/
+	Continue *string
+	/*Deployed
+	  only the services that are, or aren't, deployed
+
+	*/
+	Deployed *bool
+	/*LabelSelector
+	  only the services whose labels match, like app=web,tier!=db
+
+	*/
+	LabelSelector *string
+	/*Limit
+	  the most services to return, the rest are fetched with the continue token
+
+	*/
+	Limit *int32
+	/*Prefix
+	  only the services whose names start with this
+
+	*/
+	Prefix *string
+	/*ResourceVersion
+	  with watch, only stream the changes after this resource version. Without it every service is sent as ADDED first
+
+	*/
+	ResourceVersion *int64
+	/*Watch
+	  stream the changes to the services instead of listing them
+
+	*/
+	Watch *bool
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -99,6 +142,94 @@ func (o *ListServicesParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithBackend adds the backend to the list services params
+func (o *ListServicesParams) WithBackend(backend *string) *ListServicesParams {
+	o.SetBackend(backend)
+	return o
+}
+
+// SetBackend adds the backend to the list services params
+func (o *ListServicesParams) SetBackend(backend *string) {
+	o.Backend = backend
+}
+
+// WithContinue adds the continueVar to the list services params
+func (o *ListServicesParams) WithContinue(continueVar *string) *ListServicesParams {
+	o.SetContinue(continueVar)
+	return o
+}
+
+// SetContinue adds the continueVar to the list services params
+func (o *ListServicesParams) SetContinue(continueVar *string) {
+	o.Continue = continueVar
+}
+
+// WithDeployed adds the deployed to the list services params
+func (o *ListServicesParams) WithDeployed(deployed *bool) *ListServicesParams {
+	o.SetDeployed(deployed)
+	return o
+}
+
+// SetDeployed adds the deployed to the list services params
+func (o *ListServicesParams) SetDeployed(deployed *bool) {
+	o.Deployed = deployed
+}
+
+// WithLabelSelector adds the labelSelector to the list services params
+func (o *ListServicesParams) WithLabelSelector(labelSelector *string) *ListServicesParams {
+	o.SetLabelSelector(labelSelector)
+	return o
+}
+
+// SetLabelSelector adds the labelSelector to the list services params
+func (o *ListServicesParams) SetLabelSelector(labelSelector *string) {
+	o.LabelSelector = labelSelector
+}
+
+// WithLimit adds the limit to the list services params
+func (o *ListServicesParams) WithLimit(limit *int32) *ListServicesParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the list services params
+func (o *ListServicesParams) SetLimit(limit *int32) {
+	o.Limit = limit
+}
+
+// WithPrefix adds the prefix to the list services params
+func (o *ListServicesParams) WithPrefix(prefix *string) *ListServicesParams {
+	o.SetPrefix(prefix)
+	return o
+}
+
+// SetPrefix adds the prefix to the list services params
+func (o *ListServicesParams) SetPrefix(prefix *string) {
+	o.Prefix = prefix
+}
+
+// WithResourceVersion adds the resourceVersion to the list services params
+func (o *ListServicesParams) WithResourceVersion(resourceVersion *int64) *ListServicesParams {
+	o.SetResourceVersion(resourceVersion)
+	return o
+}
+
+// SetResourceVersion adds the resourceVersion to the list services params
+func (o *ListServicesParams) SetResourceVersion(resourceVersion *int64) {
+	o.ResourceVersion = resourceVersion
+}
+
+// WithWatch adds the watch to the list services params
+func (o *ListServicesParams) WithWatch(watch *bool) *ListServicesParams {
+	o.SetWatch(watch)
+	return o
+}
+
+// SetWatch adds the watch to the list services params
+func (o *ListServicesParams) SetWatch(watch *bool) {
+	o.Watch = watch
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ListServicesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -107,6 +238,134 @@ func (o *ListServicesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 	}
 	var res []error
 
+	if o.Backend != nil {
+
+		// query param backend
+		var qrBackend string
+		if o.Backend != nil {
+			qrBackend = *o.Backend
+		}
+		qBackend := qrBackend
+		if qBackend != "" {
+			if err := r.SetQueryParam("backend", qBackend); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Continue != nil {
+
+		// query param continue
+		var qrContinue string
+		if o.Continue != nil {
+			qrContinue = *o.Continue
+		}
+		qContinue := qrContinue
+		if qContinue != "" {
+			if err := r.SetQueryParam("continue", qContinue); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Deployed != nil {
+
+		// query param deployed
+		var qrDeployed bool
+		if o.Deployed != nil {
+			qrDeployed = *o.Deployed
+		}
+		qDeployed := swag.FormatBool(qrDeployed)
+		if qDeployed != "" {
+			if err := r.SetQueryParam("deployed", qDeployed); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.LabelSelector != nil {
+
+		// query param labelSelector
+		var qrLabelSelector string
+		if o.LabelSelector != nil {
+			qrLabelSelector = *o.LabelSelector
+		}
+		qLabelSelector := qrLabelSelector
+		if qLabelSelector != "" {
+			if err := r.SetQueryParam("labelSelector", qLabelSelector); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int32
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt32(qrLimit)
+		if qLimit != "" {
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Prefix != nil {
+
+		// query param prefix
+		var qrPrefix string
+		if o.Prefix != nil {
+			qrPrefix = *o.Prefix
+		}
+		qPrefix := qrPrefix
+		if qPrefix != "" {
+			if err := r.SetQueryParam("prefix", qPrefix); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.ResourceVersion != nil {
+
+		// query param resourceVersion
+		var qrResourceVersion int64
+		if o.ResourceVersion != nil {
+			qrResourceVersion = *o.ResourceVersion
+		}
+		qResourceVersion := swag.FormatInt64(qrResourceVersion)
+		if qResourceVersion != "" {
+			if err := r.SetQueryParam("resourceVersion", qResourceVersion); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Watch != nil {
+
+		// query param watch
+		var qrWatch bool
+		if o.Watch != nil {
+			qrWatch = *o.Watch
+		}
+		qWatch := swag.FormatBool(qrWatch)
+		if qWatch != "" {
+			if err := r.SetQueryParam("watch", qWatch); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -32,8 +32,29 @@ func (o *ListServicesReader) ReadResponse(response runtime.ClientResponse, consu
 		}
 		return result, nil
 
+	case 400:
+		result := NewListServicesBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 410:
+		result := NewListServicesGone()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
 	default:
-		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+		result := NewListServicesDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
 	}
 }
 
@@ -44,9 +65,13 @@ func NewListServicesOK() *ListServicesOK {
 
 /*ListServicesOK handles this case with default header values.
 
-list the services
+list the services ordered by name, or with watch a stream of watchEvent objects, one JSON object per line or one server-sent event each
 */
 type ListServicesOK struct {
+	/*the token that fetches the next page, missing on the last one
+	 */
+	XContinue string
+
 	Payload models.ListServicesOKBody
 }
 
@@ -56,6 +81,9 @@ func (o *ListServicesOK) Error() string {
 
 func (o *ListServicesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// response header X-Continue
+	o.XContinue = response.GetHeader("X-Continue")
+
 	// response payload
 	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
 		return err
@@ -63,3 +91,99 @@ func (o *ListServicesOK) readResponse(response runtime.ClientResponse, consumer
 
 	return nil
 }
+
+// NewListServicesBadRequest creates a ListServicesBadRequest with default headers values
+func NewListServicesBadRequest() *ListServicesBadRequest {
+	return &ListServicesBadRequest{}
+}
+
+/*ListServicesBadRequest handles this case with default header values.
+
+the label selector or continue token is invalid
+*/
+type ListServicesBadRequest struct {
+	Payload *models.Error
+}
+
+func (o *ListServicesBadRequest) Error() string {
+	return fmt.Sprintf("[GET /services][%d] listServicesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ListServicesBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListServicesGone creates a ListServicesGone with default headers values
+func NewListServicesGone() *ListServicesGone {
+	return &ListServicesGone{}
+}
+
+/*ListServicesGone handles this case with default header values.
+
+the changes after resourceVersion are no longer known, list the services and watch from there
+*/
+type ListServicesGone struct {
+	Payload *models.Error
+}
+
+func (o *ListServicesGone) Error() string {
+	return fmt.Sprintf("[GET /services][%d] listServicesGone  %+v", 410, o.Payload)
+}
+
+func (o *ListServicesGone) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListServicesDefault creates a ListServicesDefault with default headers values
+func NewListServicesDefault(code int) *ListServicesDefault {
+	return &ListServicesDefault{
+		_statusCode: code,
+	}
+}
+
+/*ListServicesDefault handles this case with default header values.
+
+error
+*/
+type ListServicesDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the list services default response
+func (o *ListServicesDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListServicesDefault) Error() string {
+	return fmt.Sprintf("[GET /services][%d] listServices default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListServicesDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/metaparticle-io/metaparticle-ast/models"
+)
+
+// ServiceWatch streams the changes to the services, see WatchServices.
+type ServiceWatch struct {
+	events chan *models.WatchEvent
+	cancel context.CancelFunc
+
+	lock sync.Mutex
+	err  error
+}
+
+// Events returns the changes to the services. It is closed when the watch
+// is stopped or ends, see Err.
+func (w *ServiceWatch) Events() <-chan *models.WatchEvent {
+	return w.events
+}
+
+// Stop ends the watch.
+func (w *ServiceWatch) Stop() {
+	w.cancel()
+}
+
+// Err returns why the watch ended once Events is closed. It is nil when the
+// watch was stopped or the server ended it. The watch isn't resumed, to keep
+// watching the caller starts a new one with the resource version of the last
+// event.
+func (w *ServiceWatch) Err() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.err
+}
+
+/*
+WatchServices starts watching the changes to the services. Without a
+resource version in params every service is sent as ADDED first. Failures
+to start the watch, like a resource version that is too old, are returned
+right away.
+*/
+func (a *Client) WatchServices(params *ListServicesParams) (*ServiceWatch, error) {
+	if params == nil {
+		params = NewListServicesParams()
+	}
+	// the watch parameters don't change the caller's
+	copied := *params
+	params = &copied
+	ctx := params.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	// a watch lasts until it is stopped
+	params = params.WithWatch(swag.Bool(true)).WithContext(ctx).WithTimeout(0)
+
+	w := &ServiceWatch{
+		events: make(chan *models.WatchEvent),
+		cancel: cancel,
+	}
+	started := make(chan struct{})
+	result := make(chan error, 1)
+	go func() {
+		defer close(w.events)
+		_, err := a.transport.Submit(&runtime.ClientOperation{
+			ID:          "listServices",
+			Method:      "GET",
+			PathPattern: "/services",
+			// newline delimited JSON, which the JSON consumer reads for failures
+			ProducesMediaTypes: []string{"application/json"},
+			ConsumesMediaTypes: []string{"application/json"},
+			Schemes:            []string{"http"},
+			Params:             params,
+			Reader: runtime.ClientResponseReaderFunc(func(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+				if response.Code() != 200 {
+					reader := &ListServicesReader{formats: a.formats}
+					return reader.ReadResponse(response, consumer)
+				}
+				close(started)
+				return nil, readWatchEvents(ctx, response.Body(), a.formats, w.events)
+			}),
+			Context: params.Context,
+			Client:  params.HTTPClient,
+		})
+		if ctx.Err() != nil {
+			// stopped
+			err = nil
+		}
+		w.lock.Lock()
+		w.err = err
+		w.lock.Unlock()
+		result <- err
+	}()
+
+	select {
+	case <-started:
+		return w, nil
+	case err := <-result:
+		cancel()
+		if err == nil {
+			// the server ended the watch before it started
+			return w, nil
+		}
+		return nil, err
+	}
+}
+
+// readWatchEvents decodes the events of a watch and sends them until the
+// stream ends or ctx is done.
+func readWatchEvents(ctx context.Context, body io.Reader, formats strfmt.Registry, events chan<- *models.WatchEvent) error {
+	decoder := json.NewDecoder(body)
+	for {
+		event := &models.WatchEvent{}
+		if err := decoder.Decode(event); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if err := event.Validate(formats); err != nil {
+			return err
+		}
+		select {
+		case events <- event:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
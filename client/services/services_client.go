@@ -52,6 +52,34 @@ func (a *Client) CreateOrUpdateService(params *CreateOrUpdateServiceParams) (*Cr
 
 }
 
+/*
+CreatePlan create plan API
+*/
+func (a *Client) CreatePlan(params *CreatePlanParams) (*CreatePlanOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreatePlanParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "createPlan",
+		Method:             "POST",
+		PathPattern:        "/plans",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &CreatePlanReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*CreatePlanOK), nil
+
+}
+
 /*
 DeleteService delete service API
 */
@@ -80,6 +108,34 @@ func (a *Client) DeleteService(params *DeleteServiceParams) (*DeleteServiceNoCon
 
 }
 
+/*
+DeployService deploy service API
+*/
+func (a *Client) DeployService(params *DeployServiceParams) (*DeployServiceOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeployServiceParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "deployService",
+		Method:             "POST",
+		PathPattern:        "/services/{name}/deploy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeployServiceReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DeployServiceOK), nil
+
+}
+
 /*
 GetService get service API
 */
@@ -108,6 +164,146 @@ func (a *Client) GetService(params *GetServiceParams) (*GetServiceOK, error) {
 
 }
 
+/*
+GetServiceLogs get service logs API
+*/
+func (a *Client) GetServiceLogs(params *GetServiceLogsParams) (*GetServiceLogsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetServiceLogsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getServiceLogs",
+		Method:             "GET",
+		PathPattern:        "/services/{name}/logs",
+		ProducesMediaTypes: []string{"application/json", "application/x-ndjson", "text/event-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetServiceLogsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetServiceLogsOK), nil
+
+}
+
+/*
+GetServiceRevision get service revision API
+*/
+func (a *Client) GetServiceRevision(params *GetServiceRevisionParams) (*GetServiceRevisionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetServiceRevisionParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getServiceRevision",
+		Method:             "GET",
+		PathPattern:        "/services/{name}/revisions/{revision}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetServiceRevisionReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetServiceRevisionOK), nil
+
+}
+
+/*
+GetServiceStatus get service status API
+*/
+func (a *Client) GetServiceStatus(params *GetServiceStatusParams) (*GetServiceStatusOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetServiceStatusParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getServiceStatus",
+		Method:             "GET",
+		PathPattern:        "/services/{name}/status",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetServiceStatusReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetServiceStatusOK), nil
+
+}
+
+/*
+ListAuditEntries list audit entries API
+*/
+func (a *Client) ListAuditEntries(params *ListAuditEntriesParams) (*ListAuditEntriesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListAuditEntriesParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listAuditEntries",
+		Method:             "GET",
+		PathPattern:        "/audit",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListAuditEntriesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListAuditEntriesOK), nil
+
+}
+
+/*
+ListServiceRevisions list service revisions API
+*/
+func (a *Client) ListServiceRevisions(params *ListServiceRevisionsParams) (*ListServiceRevisionsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListServiceRevisionsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listServiceRevisions",
+		Method:             "GET",
+		PathPattern:        "/services/{name}/revisions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListServiceRevisionsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListServiceRevisionsOK), nil
+
+}
+
 /*
 ListServices list services API
 */
@@ -121,7 +317,7 @@ func (a *Client) ListServices(params *ListServicesParams) (*ListServicesOK, erro
 		ID:                 "listServices",
 		Method:             "GET",
 		PathPattern:        "/services",
-		ProducesMediaTypes: []string{"application/json"},
+		ProducesMediaTypes: []string{"application/json", "application/x-ndjson", "text/event-stream"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
@@ -136,6 +332,62 @@ func (a *Client) ListServices(params *ListServicesParams) (*ListServicesOK, erro
 
 }
 
+/*
+RollbackService rollback service API
+*/
+func (a *Client) RollbackService(params *RollbackServiceParams) (*RollbackServiceOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRollbackServiceParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "rollbackService",
+		Method:             "POST",
+		PathPattern:        "/services/{name}/rollback",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RollbackServiceReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*RollbackServiceOK), nil
+
+}
+
+/*
+UndeployService undeploy service API
+*/
+func (a *Client) UndeployService(params *UndeployServiceParams) (*UndeployServiceOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUndeployServiceParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "undeployService",
+		Method:             "POST",
+		PathPattern:        "/services/{name}/undeploy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UndeployServiceReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UndeployServiceOK), nil
+
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -3,8 +3,10 @@ package main
 import (
 	"fmt"
 	"io/ioutil"
+	"os"
 	"time"
 
+	"github.com/go-openapi/swag"
 	"github.com/golang/glog"
 	"github.com/metaparticle-io/metaparticle-ast/client"
 	"github.com/metaparticle-io/metaparticle-ast/client/services"
@@ -13,9 +15,17 @@ import (
 )
 
 var (
-	port = flag.Int("port", 8080, "The port to connect to.")
-	host = flag.String("host", "localhost", "The host to connect to")
-	file = flag.StringP("file", "f", "", "The config file to load")
+	port     = flag.Int("port", 8080, "The port to connect to.")
+	host     = flag.String("host", "localhost", "The host to connect to")
+	file     = flag.StringP("file", "f", "", "The config file to load")
+	logs     = flag.String("logs", "", "The name of a service whose logs to follow")
+	watch    = flag.Bool("watch", false, "If true, print the changes to the services as they happen")
+	selector = flag.StringP("selector", "l", "", "Only list or watch the services whose labels match this selector, e.g. 'tier=web,env!=test'")
+	prefix   = flag.String("prefix", "", "Only list or watch the services whose names start with this prefix")
+	token    = flag.String("token", os.Getenv("METAPARTICLE_TOKEN"), "The bearer token to authenticate with, defaults to $METAPARTICLE_TOKEN")
+	cert     = flag.String("cert", "", "The client certificate to authenticate with, connects with https")
+	key      = flag.String("key", "", "The private key of --cert")
+	ca       = flag.String("ca", "", "The certificate authority of the server, connects with https")
 )
 
 func main() {
@@ -24,8 +34,45 @@ func main() {
 
 	addr := fmt.Sprintf("%s:%d", *host, *port)
 
-	tc := client.DefaultTransportConfig().WithHost(addr)
-	c := client.NewHTTPClientWithConfig(nil, tc)
+	c, err := client.NewHTTPClientWithCredentials(nil, addr, &client.Credentials{
+		Token:    *token,
+		CertFile: *cert,
+		KeyFile:  *key,
+		CAFile:   *ca,
+	})
+	if err != nil {
+		glog.Fatalf("Couldn't load the credentials: %v", err)
+	}
+
+	if len(*logs) != 0 {
+		params := services.NewGetServiceLogsParams().WithName(*logs).WithFollow(swag.Bool(true))
+		err := c.Services.StreamServiceLogs(params, func(event *models.LogEvent) error {
+			source := event.Container
+			if len(event.Pod) > 0 {
+				source = event.Pod + ":" + source
+			}
+			fmt.Printf("%s %s\n", source, *event.Message)
+			return nil
+		})
+		if err != nil {
+			glog.Fatalf("Failed to stream logs: %v", err)
+		}
+		return
+	}
+
+	if *watch {
+		w, err := c.Services.WatchServices(listParams())
+		if err != nil {
+			glog.Fatalf("Failed to watch: %v", err)
+		}
+		for event := range w.Events() {
+			fmt.Printf("%s %s %d\n", *event.Type, *event.Service.Name, event.Service.ResourceVersion)
+		}
+		if err := w.Err(); err != nil {
+			glog.Fatalf("Watch failed: %v", err)
+		}
+		return
+	}
 
 	if len(*file) != 0 {
 		obj := &models.Service{}
@@ -52,25 +99,44 @@ func main() {
 			updateParams.Name = *obj.Name
 			_, err := c.Services.CreateOrUpdateService(updateParams)
 			if err != nil {
-				glog.Fatalf("Failed to update: %#v", err.(*services.CreateOrUpdateServiceDefault).Payload)
+				glog.Fatalf("Failed to update: %s", client.UpdateError(err))
 			}
 			return
 		}
 		glog.Infof("Found: %#v", resp)
 	}
 
-	resp, err := c.Services.GetServices(nil)
-	if err != nil {
-		glog.Fatalf("Error: %v\n", err)
-	}
+	params := listParams().WithLimit(swag.Int32(100))
 	glog.Infof("[")
-	for _, obj := range resp.Payload {
-		bytes, err := obj.MarshalBinary()
+	for {
+		resp, err := c.Services.ListServices(params)
 		if err != nil {
-			glog.Warningf("Unexpected error: %v", err)
-			continue
+			glog.Fatalf("Error: %v\n", err)
+		}
+		for _, obj := range resp.Payload {
+			bytes, err := obj.MarshalBinary()
+			if err != nil {
+				glog.Warningf("Unexpected error: %v", err)
+				continue
+			}
+			glog.Infof("%s\n", string(bytes))
 		}
-		glog.Infof("%s\n", string(bytes))
+		if len(resp.XContinue) == 0 {
+			break
+		}
+		params = params.WithContinue(swag.String(resp.XContinue))
 	}
 	glog.Infof("]")
 }
+
+// listParams returns the parameters selecting the services to list or watch.
+func listParams() *services.ListServicesParams {
+	params := services.NewListServicesParams()
+	if len(*selector) > 0 {
+		params = params.WithLabelSelector(selector)
+	}
+	if len(*prefix) > 0 {
+		params = params.WithPrefix(prefix)
+	}
+	return params
+}
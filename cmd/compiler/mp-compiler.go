@@ -19,15 +19,22 @@ import (
 )
 
 var (
-	port   = flag.Int("port", 8080, "The port to connect to.")
-	host   = flag.String("host", "", "The host to connect to")
-	file   = flag.StringP("file", "f", "", "The config file to load")
-	name   = flag.StringP("name", "n", "", "The name of the service to compile")
-	dryrun = flag.Bool("dry-run", false, "If true, only output the execution plan, don't actually enact it.")
-	del    = flag.Bool("delete", false, "If true, instead of creating, delete the service.")
-	exec   = flag.String("executor", "kubernetes", "The executor to use. Default is 'kubernetes'")
-	attach = flag.Bool("attach", false, "If true, then attach to the service in question.")
-	deploy = flag.Bool("deploy", true, "If true, deploy or update the service")
+	port        = flag.Int("port", 8080, "The port to connect to.")
+	host        = flag.String("host", "", "The host to connect to")
+	file        = flag.StringP("file", "f", "", "The config file to load")
+	name        = flag.StringP("name", "n", "", "The name of the service to compile")
+	dryrun      = flag.Bool("dry-run", false, "If true, only output the execution plan, don't actually enact it.")
+	del         = flag.Bool("delete", false, "If true, instead of creating, delete the service.")
+	exec        = flag.String("executor", "kubernetes", "The executor to use. Default is 'kubernetes'")
+	attach      = flag.Bool("attach", false, "If true, then attach to the service in question.")
+	deploy      = flag.Bool("deploy", true, "If true, deploy or update the service")
+	wait        = flag.Bool("wait", false, "If true, wait for the jobs to finish, stream their logs and exit non-zero if any of them fails.")
+	waitTimeout = flag.Duration("wait-timeout", 0, "How long to wait for the jobs with --wait, zero waits forever.")
+	ifMatch     = flag.String("if-match", "", "With --host, only update the service if its ETag on the server is still this one.")
+	token       = flag.String("token", os.Getenv("METAPARTICLE_TOKEN"), "With --host, the bearer token to authenticate with, defaults to $METAPARTICLE_TOKEN")
+	cert        = flag.String("cert", "", "With --host, the client certificate to authenticate with, connects with https")
+	key         = flag.String("key", "", "With --host, the private key of --cert")
+	ca          = flag.String("ca", "", "With --host, the certificate authority of the server, connects with https")
 )
 
 func main() {
@@ -38,8 +45,16 @@ func main() {
 	var c *client.AnApplicationForEasierDistributedApplicationGeneration
 	if len(*host) > 0 {
 		addr := fmt.Sprintf("%s:%d", *host, *port)
-		tc := client.DefaultTransportConfig().WithHost(addr)
-		c = client.NewHTTPClientWithConfig(nil, tc)
+		var err error
+		c, err = client.NewHTTPClientWithCredentials(nil, addr, &client.Credentials{
+			Token:    *token,
+			CertFile: *cert,
+			KeyFile:  *key,
+			CAFile:   *ca,
+		})
+		if err != nil {
+			glog.Fatalf("Couldn't load the credentials: %v", err)
+		}
 	}
 
 	if len(*file) == 0 && len(*name) == 0 {
@@ -58,10 +73,14 @@ func main() {
 			updateParams := services.NewCreateOrUpdateServiceParamsWithTimeout(5 * time.Second)
 			updateParams.Body = obj
 			updateParams.Name = *obj.Name
-			_, err = c.Services.CreateOrUpdateService(updateParams)
+			if len(*ifMatch) > 0 {
+				updateParams.IfMatch = ifMatch
+			}
+			resp, err := c.Services.CreateOrUpdateService(updateParams)
 			if err != nil {
-				glog.Fatalf("Failed to update: %s", err.Error())
+				glog.Fatalf("Failed to update: %s", client.UpdateError(err))
 			}
+			glog.Infof("Updated %s, its ETag is %s", *obj.Name, resp.ETag)
 		}
 	}
 
@@ -122,6 +141,16 @@ func main() {
 			glog.Fatalf(err.Error())
 		}
 	}
+	if *wait && !*dryrun && !*del && len(obj.Jobs) > 0 {
+		results, err := cmp.Wait(obj, *waitTimeout, os.Stdout, os.Stderr)
+		for _, result := range results {
+			fmt.Println(result)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Jobs didn't succeed: %v\n", err)
+			os.Exit(1)
+		}
+	}
 	if *attach {
 		if err := cmp.Logs(obj, os.Stdout, os.Stderr); err != nil {
 			glog.Fatalf(err.Error())
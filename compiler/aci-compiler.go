@@ -1,8 +1,10 @@
 package compiler
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
+	"os/exec"
 	"strconv"
 	"time"
 
@@ -35,20 +37,67 @@ func (a *aciCompiler) Delete(opts *CompilerOptions, svc *models.Service) (Plan,
 
 func (a *aciPlan) Execute(dryrun bool) error {
 	rg := "test"
-	for ix := range a.service.Services {
-		if err := a.runService(a.service.Services[ix], rg, a.service.Serve, dryrun); err != nil {
+	if hasIngress(a.service) {
+		return fmt.Errorf("ACI runtime doesn't support hostname or path routing")
+	}
+	if err := validateImages(a.service); err != nil {
+		return err
+	}
+	if hasSecurity(a.service) {
+		return fmt.Errorf("ACI runtime doesn't support security contexts or service accounts")
+	}
+	if err := validateAciNetwork(a.service); err != nil {
+		return err
+	}
+	// dependencies are created first, so that their IP addresses are known
+	services, err := deployOrder(a.service)
+	if err != nil {
+		return err
+	}
+	for _, service := range services {
+		if err := a.runService(service, rg, dryrun); err != nil {
+			return err
+		}
+	}
+	for ix := range a.service.Jobs {
+		if err := a.runJob(a.service.Jobs[ix], rg, dryrun); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (a *aciPlan) runService(spec *models.ServiceSpecification, resourceGroup string, serve *models.ServeSpecification, dryrun bool) error {
+// host returns a function that looks up the IP address of a container group.
+func (a *aciPlan) host(resourceGroup string, dryrun bool) func(*models.ServiceSpecification) (string, error) {
+	return func(service *models.ServiceSpecification) (string, error) {
+		cmd := []string{"az", "container", "show", "-g", resourceGroup, "-n", *service.Name, "--query", "ipAddress.ip", "-o", "tsv"}
+		if dryrun {
+			dryRunCommand(a.opts.writer(), cmd)
+			return fmt.Sprintf("<%s-ip-address>", *service.Name), nil
+		}
+		ip, err := executeCommandOutput(cmd)
+		if err != nil {
+			return "", err
+		}
+		if len(ip) == 0 {
+			return "", fmt.Errorf("%s has no IP address, ACI services need a port to be a dependency", *service.Name)
+		}
+		return ip, nil
+	}
+}
+
+func (a *aciPlan) runService(spec *models.ServiceSpecification, resourceGroup string, dryrun bool) error {
 	if spec.Replicas > 1 || spec.ShardSpec != nil {
 		return fmt.Errorf("ACI runtime doesn't support replication or sharding")
 	}
 	image := *spec.Containers[0].Image
-	cmd := []string{"az", "container", "create", "-g", resourceGroup, "-n", *a.service.Name, "--image", image}
+	cmd := []string{"az", "container", "create", "-g", resourceGroup, "-n", *spec.Name, "--image", image}
+
+	registryArgs, err := a.registryArgs(image, dryrun)
+	if err != nil {
+		return err
+	}
+	cmd = append(cmd, registryArgs...)
 
 	switch len(spec.Ports) {
 	case 0:
@@ -60,20 +109,113 @@ func (a *aciPlan) runService(spec *models.ServiceSpecification, resourceGroup st
 		return fmt.Errorf("ACI runtime doesn't support multiple ports (for now)")
 	}
 
-	if len(spec.Containers[0].Env) > 0 {
-		cmd = append(cmd, "-e")
-		for _, env := range spec.Containers[0].Env {
-			cmd = append(cmd, fmt.Sprintf("%s=%s", *env.Name, *env.Value))
+	env, err := dependencyEnv(a.service, spec.Depends, a.host(resourceGroup, dryrun))
+	if err != nil {
+		return err
+	}
+	cmd = append(cmd, aciEnvArgs(env, spec.Containers[0])...)
+
+	cmd = append(cmd, a.networkArgs(*spec.Name)...)
+
+	return executeCommand(a.opts.writer(), cmd, dryrun)
+}
+
+// runJob creates a container group for every completion of a job. ACI
+// doesn't support retry limits or deadlines, only the restart policy.
+func (a *aciPlan) runJob(job *models.JobSpecification, resourceGroup string, dryrun bool) error {
+	if err := validateJob(job); err != nil {
+		return err
+	}
+	image := *job.Containers[0].Image
+	env, err := dependencyEnv(a.service, job.Depends, a.host(resourceGroup, dryrun))
+	if err != nil {
+		return err
+	}
+	registryArgs, err := a.registryArgs(image, dryrun)
+	if err != nil {
+		return err
+	}
+	for ix := int32(0); ix < jobCompletions(job); ix++ {
+		cmd := []string{"az", "container", "create", "-g", resourceGroup, "-n", jobInstanceName(job, ix), "--image", image, "--restart-policy", jobRestartPolicy(job)}
+		cmd = append(cmd, registryArgs...)
+		cmd = append(cmd, aciEnvArgs(append(env, jobIndexEnv(job, ix)...), job.Containers[0])...)
+		cmd = append(cmd, a.networkArgs(*job.Name)...)
+		if err := executeCommand(a.opts.writer(), cmd, dryrun); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// usesVnet returns true if a service that isn't public is a dependency, in
+// which case the container groups of the application share a virtual network
+// so that it is reached by its private IP address.
+func usesVnet(svc *models.Service) bool {
+	for _, service := range svc.Services {
+		if !isPublic(svc, *service.Name) && len(dependents(svc, *service.Name)) > 0 {
+			return true
 		}
 	}
+	return false
+}
 
-	if serve != nil {
-		if *serve.Name == *spec.Name && serve.Public {
-			cmd = append(cmd, "--ip-address", "public")
+// validateAciNetwork checks that public services only depend on public
+// services, container groups with a public IP can't join a virtual network.
+func validateAciNetwork(svc *models.Service) error {
+	for _, service := range svc.Services {
+		if !isPublic(svc, *service.Name) {
+			continue
+		}
+		for _, dep := range parseDepends(service.Depends) {
+			if !isPublic(svc, dep) {
+				return fmt.Errorf("ACI runtime can't reach %s from public service %s, only services without a public IP address can reach private dependencies", dep, *service.Name)
+			}
 		}
 	}
+	return nil
+}
+
+// networkArgs returns how a container group is addressed. Public services
+// get a public IP, the others join the virtual network of the application
+// if it needs one.
+func (a *aciPlan) networkArgs(name string) []string {
+	if isPublic(a.service, name) {
+		return []string{"--ip-address", "public"}
+	}
+	if !usesVnet(a.service) {
+		return nil
+	}
+	app := *a.service.Name
+	return []string{"--vnet", app + "-vnet", "--subnet", app + "-subnet"}
+}
+
+// registryArgs returns the credentials of the registry an image is pulled from.
+func (a *aciPlan) registryArgs(image string, dryrun bool) ([]string, error) {
+	registry := registryFor(a.service, image)
+	if registry == nil || len(registry.Username) == 0 {
+		return nil, nil
+	}
+	password, err := registryPassword(registry, dryrun)
+	if err != nil {
+		return nil, err
+	}
+	return []string{"--registry-login-server", *registry.Server, "--registry-username", registry.Username, "--registry-password", password}, nil
+}
 
-	return executeCommand(cmd, dryrun)
+// aciEnvArgs returns the environment of a container, its own environment
+// comes last so that it takes precedence.
+func aciEnvArgs(env []envVar, container *models.Container) []string {
+	if len(env) == 0 && len(container.Env) == 0 {
+		return nil
+	}
+	args := []string{"-e"}
+	for _, e := range env {
+		args = append(args, fmt.Sprintf("%s=%s", e.name, e.value))
+	}
+	for _, env := range container.Env {
+		args = append(args, fmt.Sprintf("%s=%s", *env.Name, *env.Value))
+	}
+	return args
 }
 
 func (a *aciPlan) Dump(dir string) error {
@@ -87,12 +229,20 @@ func (a *aciDeletePlan) Execute(dryrun bool) error {
 			return err
 		}
 	}
+	for _, job := range a.service.Jobs {
+		for ix := int32(0); ix < jobCompletions(job); ix++ {
+			cmd := []string{"az", "container", "delete", "-g", rg, "-n", jobInstanceName(job, ix)}
+			if err := executeCommand(a.opts.writer(), cmd, dryrun); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
 func (a *aciDeletePlan) deleteService(spec *models.ServiceSpecification, resourceGroup string, dryrun bool) error {
-	cmd := []string{"az", "container", "delete", "-g", resourceGroup, "-n", *a.service.Name}
-	return executeCommand(cmd, dryrun)
+	cmd := []string{"az", "container", "delete", "-g", resourceGroup, "-n", *spec.Name}
+	return executeCommand(a.opts.writer(), cmd, dryrun)
 }
 
 func (a *aciDeletePlan) Dump(dir string) error {
@@ -109,3 +259,130 @@ func (k *aciCompiler) Logs(svc *models.Service, stdout, stderr io.Writer) error
 		time.Sleep(2 * time.Second)
 	}
 }
+
+// aciContainerState is the current state of the container of a container group.
+type aciContainerState struct {
+	State        string `json:"state"`
+	ExitCode     int32  `json:"exitCode"`
+	DetailStatus string `json:"detailStatus"`
+}
+
+func (k *aciCompiler) Wait(svc *models.Service, timeout time.Duration, stdout, stderr io.Writer) ([]*JobResult, error) {
+	// TODO: fix this hard-code 'test'
+	rg := "test"
+	return pollJobs(svc, timeout, func(job *models.JobSpecification, name string) (*JobResult, error) {
+		out, err := executeCommandOutput([]string{"az", "container", "show", "-g", rg, "-n", name, "--query", "containers[0].instanceView.currentState", "-o", "json"})
+		if err != nil {
+			return nil, err
+		}
+		state := aciContainerState{}
+		if err := json.Unmarshal([]byte(out), &state); err != nil {
+			return nil, err
+		}
+		if state.State != "Terminated" {
+			return nil, nil
+		}
+		if state.ExitCode != 0 && jobRestartPolicy(job) == models.JobSpecificationRestartPolicyOnFailure {
+			// ACI is going to restart it
+			return nil, nil
+		}
+		// ACI can't follow the logs of a container group, so they are printed once it's done
+		if err := executeCommandStreaming([]string{"az", "container", "logs", "-g", rg, "-n", name}, stdout, stderr); err != nil {
+			return nil, err
+		}
+		return &JobResult{
+			Job:       *job.Name,
+			Name:      name,
+			Succeeded: state.ExitCode == 0,
+			ExitCode:  state.ExitCode,
+			Reason:    state.DetailStatus,
+		}, nil
+	})
+}
+
+// aciGroupState is the state of a container group and its first container.
+type aciGroupState struct {
+	IP        string            `json:"ip"`
+	Container aciContainerState `json:"container"`
+}
+
+// aciState returns the state of a container group, or nil if it doesn't exist.
+func aciState(resourceGroup, name string) (*aciGroupState, error) {
+	out, err := executeCommandOutput([]string{"az", "container", "show", "-g", resourceGroup, "-n", name, "--query", "{ip: ipAddress.ip, container: containers[0].instanceView.currentState}", "-o", "json"})
+	if _, failed := err.(*exec.ExitError); failed {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	state := &aciGroupState{}
+	if err := json.Unmarshal([]byte(out), state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func (k *aciCompiler) Status(svc *models.Service) (*models.Status, error) {
+	// TODO: fix this hard-code 'test'
+	rg := "test"
+	status := newStatus(svc, "aci")
+	for _, service := range svc.Services {
+		serviceStatus := newServiceStatus(service)
+		// ACI runs a single container group for every service
+		serviceStatus.Replicas = 1
+		state, err := aciState(rg, *service.Name)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case state == nil:
+			serviceStatus.Failures = addFailure(serviceStatus.Failures, fmt.Sprintf("container group %s doesn't exist", *service.Name))
+		case state.Container.State == "Running":
+			serviceStatus.ReadyReplicas = 1
+		case state.Container.State == "Terminated":
+			serviceStatus.Failures = addFailure(serviceStatus.Failures, fmt.Sprintf("%s: %s (exit code %d)", *service.Name, state.Container.DetailStatus, state.Container.ExitCode))
+		}
+		if state != nil && len(state.IP) > 0 && isPublic(svc, *service.Name) {
+			serviceStatus.Endpoints = portEndpoints(state.IP, service.Ports)
+		}
+		status.Services = append(status.Services, serviceStatus)
+	}
+	for _, job := range svc.Jobs {
+		jobStatus := newJobStatus(job)
+		for ix := int32(0); ix < jobCompletions(job); ix++ {
+			name := jobInstanceName(job, ix)
+			state, err := aciState(rg, name)
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case state == nil:
+				jobStatus.Failures = addFailure(jobStatus.Failures, fmt.Sprintf("container group %s doesn't exist", name))
+			case state.Container.State != "Terminated":
+				jobStatus.Active++
+			case state.Container.ExitCode == 0:
+				jobStatus.Succeeded++
+			default:
+				jobStatus.Failed++
+				jobStatus.Failures = addFailure(jobStatus.Failures, fmt.Sprintf("%s: %s (exit code %d)", name, state.Container.DetailStatus, state.Container.ExitCode))
+			}
+		}
+		status.Jobs = append(status.Jobs, jobStatus)
+	}
+	return status, nil
+}
+
+func (k *aciCompiler) StreamLogs(svc *models.Service, opts *LogOptions, stop <-chan struct{}, handle func(*models.LogEvent)) error {
+	if !opts.Since.IsZero() {
+		return fmt.Errorf("the aci backend can't skip the logs written before a time")
+	}
+	// TODO: fix this hard-code 'test'
+	rg := "test"
+	return streamCommands(logSources(svc, opts), stop, handle, func(source logSource) []string {
+		cmd := []string{"az", "container", "logs", "-g", rg, "-n", source.container}
+		if opts.Follow {
+			cmd = append(cmd, "--follow")
+		}
+		return cmd
+	})
+}
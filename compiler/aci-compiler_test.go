@@ -0,0 +1,94 @@
+package compiler
+
+import (
+	"strings"
+	"testing"
+)
+
+// createCommands returns the 'az container create' commands of a dry run by
+// container group.
+func createCommands(out *recorder) map[string]string {
+	result := map[string]string{}
+	for _, cmd := range out.commands {
+		if len(cmd) > 6 && cmd[1] == "container" && cmd[2] == "create" {
+			result[cmd[6]] = strings.Join(cmd, " ")
+		}
+	}
+	return result
+}
+
+func TestAciNetwork(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     string
+		expected map[string]string
+		err      string
+	}{
+		{
+			name: "no dependencies",
+			spec: `{"name": "app", "services": [{"name": "web", "containers": [{"image": "web"}], "ports": [{"number": 80}]}, {"name": "worker", "containers": [{"image": "worker"}]}], "serve": {"name": "web", "public": true}}`,
+			expected: map[string]string{
+				"web":    "--ip-address public",
+				"worker": "",
+			},
+		},
+		{
+			name: "private dependency",
+			spec: `{"name": "app", "services": [{"name": "db", "containers": [{"image": "db"}], "ports": [{"number": 5432}]}, {"name": "worker", "containers": [{"image": "worker"}], "depends": "db"}], "jobs": [{"name": "migrate", "containers": [{"image": "migrate"}], "depends": "db"}]}`,
+			expected: map[string]string{
+				"db":      "--vnet app-vnet --subnet app-subnet",
+				"worker":  "--vnet app-vnet --subnet app-subnet",
+				"migrate": "--vnet app-vnet --subnet app-subnet",
+			},
+		},
+		{
+			name: "public dependency",
+			spec: `{"name": "app", "services": [{"name": "api", "containers": [{"image": "api"}], "ports": [{"number": 80}]}, {"name": "worker", "containers": [{"image": "worker"}], "depends": "api"}], "serve": {"name": "api", "public": true}}`,
+			expected: map[string]string{
+				"api":    "--ip-address public",
+				"worker": "",
+			},
+		},
+		{
+			name: "public service with private dependency",
+			spec: `{"name": "app", "services": [{"name": "db", "containers": [{"image": "db"}], "ports": [{"number": 5432}]}, {"name": "web", "containers": [{"image": "web"}], "ports": [{"number": 80}], "depends": "db"}], "serve": {"name": "web", "public": true}}`,
+			err:  "can't reach db from public service web",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out := newRecorder()
+			plan := &aciPlan{opts: &CompilerOptions{Output: out}, service: parseService(t, test.spec)}
+			err := plan.Execute(true)
+			if len(test.err) > 0 {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Errorf("expected an error containing %q, got %v", test.err, err)
+				}
+				if len(out.commands) > 0 {
+					t.Errorf("expected nothing to be created, got %v", out.commands)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			commands := createCommands(out)
+			if len(commands) != len(test.expected) {
+				t.Fatalf("expected %d container groups, got %v", len(test.expected), commands)
+			}
+			for name, args := range test.expected {
+				cmd, found := commands[name]
+				if !found {
+					t.Errorf("no container group %s", name)
+					continue
+				}
+				if len(args) > 0 && !strings.HasSuffix(cmd, args) {
+					t.Errorf("%s: expected %q, got %s", name, args, cmd)
+				}
+				if len(args) == 0 && (strings.Contains(cmd, "--ip-address") || strings.Contains(cmd, "--vnet")) {
+					t.Errorf("%s: expected no network arguments, got %s", name, cmd)
+				}
+			}
+		})
+	}
+}
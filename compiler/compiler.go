@@ -2,12 +2,36 @@ package compiler
 
 import (
 	"io"
+	"os"
+	"time"
 
 	"github.com/metaparticle-io/metaparticle-ast/models"
 )
 
 type CompilerOptions struct {
 	WorkingDirectory string
+	// Output receives the commands and objects a plan executes, or would
+	// execute on a dry run. It defaults to stdout.
+	Output io.Writer
+}
+
+// StepRecorder can be implemented by the Output of CompilerOptions to get
+// the steps of a plan one by one instead of as text.
+type StepRecorder interface {
+	// Command receives a command line a dry run would execute.
+	Command(cmd []string)
+	// Object receives a Kubernetes object the plan creates or updates.
+	Object(name string, obj interface{})
+	// Note receives anything else a dry run would have done.
+	Note(message string)
+}
+
+// writer returns where a plan reports what it does.
+func (o *CompilerOptions) writer() io.Writer {
+	if o == nil || o.Output == nil {
+		return os.Stdout
+	}
+	return o.Output
 }
 
 // Compiler is an interface for things that know how to compile metaparticle models
@@ -18,6 +42,15 @@ type Compiler interface {
 	Delete(opts *CompilerOptions, svc *models.Service) (Plan, error)
 	// Tail the logs for an existing service
 	Logs(svc *models.Service, stdout, stderr io.Writer) error
+	// Wait for the jobs of a service to finish, streaming their logs. A zero
+	// timeout waits forever.
+	Wait(svc *models.Service, timeout time.Duration, stdout, stderr io.Writer) ([]*JobResult, error)
+	// Status reports the state of the services and jobs of a service.
+	Status(svc *models.Service) (*models.Status, error)
+	// StreamLogs passes the log lines of the containers of a service to
+	// handle until stop is closed or, unless opts.Follow is set, until the
+	// lines written so far have been read.
+	StreamLogs(svc *models.Service, opts *LogOptions, stop <-chan struct{}, handle func(*models.LogEvent)) error
 }
 
 type Plan interface {
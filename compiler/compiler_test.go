@@ -0,0 +1,70 @@
+package compiler
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/metaparticle-io/metaparticle-ast/models"
+	"k8s.io/api/core/v1"
+)
+
+// parseService parses a service spec written as JSON.
+func parseService(t *testing.T, spec string) *models.Service {
+	t.Helper()
+	svc := &models.Service{}
+	if err := svc.UnmarshalBinary([]byte(spec)); err != nil {
+		t.Fatalf("can't parse %s: %v", spec, err)
+	}
+	return svc
+}
+
+// orNull returns the JSON value, or null when it is empty.
+func orNull(value string) string {
+	if len(value) == 0 {
+		return "null"
+	}
+	return value
+}
+
+// recorder collects the steps of a dry run.
+type recorder struct {
+	bytes.Buffer
+	commands [][]string
+	objects  map[string]interface{}
+	notes    []string
+}
+
+func newRecorder() *recorder {
+	return &recorder{objects: map[string]interface{}{}}
+}
+
+func (r *recorder) Command(cmd []string) {
+	r.commands = append(r.commands, cmd)
+}
+
+func (r *recorder) Object(name string, obj interface{}) {
+	r.objects[name] = obj
+}
+
+func (r *recorder) Note(message string) {
+	r.notes = append(r.notes, message)
+}
+
+// dryRunPlan returns a Kubernetes plan of svc that only records what it does.
+func dryRunPlan(svc *models.Service) (*kubernetesPlan, *recorder) {
+	out := newRecorder()
+	return &kubernetesPlan{
+		opts:    &CompilerOptions{Output: out},
+		service: svc,
+		dryrun:  true,
+	}, out
+}
+
+// envMap returns the values of an environment by name.
+func envMap(env []v1.EnvVar) map[string]string {
+	result := map[string]string{}
+	for _, e := range env {
+		result[e.Name] = e.Value
+	}
+	return result
+}
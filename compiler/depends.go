@@ -0,0 +1,145 @@
+package compiler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/metaparticle-io/metaparticle-ast/models"
+)
+
+// parseDepends splits a comma separated 'depends' field into service names.
+func parseDepends(depends string) []string {
+	names := []string{}
+	for _, name := range strings.Split(depends, ",") {
+		if name = strings.TrimSpace(name); len(name) > 0 {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// dependents returns the names of the services and jobs that depend on the named service.
+func dependents(svc *models.Service, name string) []string {
+	result := []string{}
+	for _, s := range svc.Services {
+		for _, dep := range parseDepends(s.Depends) {
+			if dep == name {
+				result = append(result, *s.Name)
+			}
+		}
+	}
+	for _, j := range svc.Jobs {
+		for _, dep := range parseDepends(j.Depends) {
+			if dep == name {
+				result = append(result, *j.Name)
+			}
+		}
+	}
+	return result
+}
+
+// envVar is a backend neutral environment variable.
+type envVar struct {
+	name  string
+	value string
+}
+
+// envPrefix returns the prefix of the environment variables that point at a service.
+func envPrefix(name string) string {
+	return strings.ToUpper(strings.Replace(name, "-", "_", -1))
+}
+
+// dependencyEnv returns the environment variables that tell a container how
+// to reach its dependencies, e.g. COMPUTE_SERVICE_HOST and COMPUTE_SERVICE_PORT.
+// Services with several ports also get COMPUTE_SERVICE_PORT_<key> for each
+// port. host resolves the address of a dependency on a particular backend.
+func dependencyEnv(svc *models.Service, depends string, host func(*models.ServiceSpecification) (string, error)) ([]envVar, error) {
+	env := []envVar{}
+	for _, dep := range parseDepends(depends) {
+		target := findService(svc, dep)
+		if target == nil {
+			return nil, fmt.Errorf("unknown dependency %s", dep)
+		}
+		address, err := host(target)
+		if err != nil {
+			return nil, err
+		}
+		prefix := envPrefix(dep)
+		env = append(env, envVar{prefix + "_SERVICE_HOST", address})
+		ports := target.Ports
+		if target.ShardSpec != nil {
+			ports = shardedPorts(target)
+		}
+		if len(ports) > 0 {
+			env = append(env, envVar{prefix + "_SERVICE_PORT", strconv.Itoa(int(*ports[0].Number))})
+		}
+		if len(ports) > 1 {
+			for _, port := range ports {
+				env = append(env, envVar{prefix + "_SERVICE_PORT_" + shardPortKey(port), strconv.Itoa(int(*port.Number))})
+			}
+		}
+	}
+	return env, nil
+}
+
+// deployOrder returns the services ordered so that every service comes after
+// the services it depends on.
+func deployOrder(svc *models.Service) ([]*models.ServiceSpecification, error) {
+	result := []*models.ServiceSpecification{}
+	state := map[string]int{}
+	var visit func(service *models.ServiceSpecification) error
+	visit = func(service *models.ServiceSpecification) error {
+		switch state[*service.Name] {
+		case 1:
+			return fmt.Errorf("%s: circular dependency", *service.Name)
+		case 2:
+			return nil
+		}
+		state[*service.Name] = 1
+		for _, dep := range parseDepends(service.Depends) {
+			target := findService(svc, dep)
+			if target == nil {
+				return fmt.Errorf("%s: depends on unknown service %s", *service.Name, dep)
+			}
+			if err := visit(target); err != nil {
+				return err
+			}
+		}
+		state[*service.Name] = 2
+		result = append(result, service)
+		return nil
+	}
+	for _, service := range svc.Services {
+		if err := visit(service); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// validateDepends checks that every dependency refers to a service of the spec.
+func validateDepends(svc *models.Service) error {
+	check := func(owner, depends string) error {
+		for _, dep := range parseDepends(depends) {
+			if findService(svc, dep) == nil {
+				return fmt.Errorf("%s: depends on unknown service %s", owner, dep)
+			}
+			if dep == owner {
+				return fmt.Errorf("%s: can't depend on itself", owner)
+			}
+		}
+		return nil
+	}
+	for _, s := range svc.Services {
+		if err := check(*s.Name, s.Depends); err != nil {
+			return err
+		}
+	}
+	for _, j := range svc.Jobs {
+		if err := check(*j.Name, j.Depends); err != nil {
+			return err
+		}
+	}
+	return nil
+}
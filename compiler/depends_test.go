@@ -0,0 +1,137 @@
+package compiler
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/metaparticle-io/metaparticle-ast/models"
+)
+
+const dependsSpec = `{
+	"name": "app",
+	"services": [
+		{"name": "web", "ports": [{"number": 80}], "depends": "compute, users"},
+		{"name": "compute", "ports": [{"number": 8080, "name": "http"}, {"number": 9090, "name": "grpc"}]},
+		{"name": "users", "ports": [{"number": 80}, {"number": 9100, "direct": true}], "shardSpec": {"shards": 2}},
+		{"name": "cache", "depends": "users"}
+	],
+	"jobs": [{"name": "migrate", "depends": "users"}]
+}`
+
+func TestDependencyEnv(t *testing.T) {
+	svc := parseService(t, dependsSpec)
+	host := func(service *models.ServiceSpecification) (string, error) {
+		return *service.Name + ".local", nil
+	}
+	tests := []struct {
+		depends  string
+		expected string
+		err      string
+	}{
+		{depends: "", expected: ""},
+		{depends: "cache", expected: "CACHE_SERVICE_HOST=cache.local"},
+		{depends: "users", expected: "USERS_SERVICE_HOST=users.local USERS_SERVICE_PORT=80"},
+		{
+			depends:  "compute",
+			expected: "COMPUTE_SERVICE_HOST=compute.local COMPUTE_SERVICE_PORT=8080 COMPUTE_SERVICE_PORT_HTTP=8080 COMPUTE_SERVICE_PORT_GRPC=9090",
+		},
+		{depends: "compute,missing", err: "unknown dependency missing"},
+	}
+	for _, test := range tests {
+		env, err := dependencyEnv(svc, test.depends, host)
+		if len(test.err) > 0 {
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("%q: expected an error containing %q, got %v", test.depends, test.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", test.depends, err)
+			continue
+		}
+		pairs := []string{}
+		for _, e := range env {
+			pairs = append(pairs, e.name+"="+e.value)
+		}
+		if strings.Join(pairs, " ") != test.expected {
+			t.Errorf("%q: got %v, expected %s", test.depends, pairs, test.expected)
+		}
+	}
+}
+
+func TestDeployOrder(t *testing.T) {
+	tests := []struct {
+		spec     string
+		expected string
+		err      string
+	}{
+		{spec: dependsSpec, expected: "compute users web cache"},
+		{
+			spec: `{"name": "app", "services": [{"name": "a", "depends": "b"}, {"name": "b", "depends": "a"}]}`,
+			err:  "circular dependency",
+		},
+	}
+	for _, test := range tests {
+		services, err := deployOrder(parseService(t, test.spec))
+		if len(test.err) > 0 {
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("expected an error containing %q, got %v", test.err, err)
+			}
+			continue
+		}
+		names := []string{}
+		for _, service := range services {
+			names = append(names, *service.Name)
+		}
+		if strings.Join(names, " ") != test.expected {
+			t.Errorf("got %v, expected %s", names, test.expected)
+		}
+	}
+	svc := parseService(t, dependsSpec)
+	if from := strings.Join(dependents(svc, "users"), " "); from != "web cache migrate" {
+		t.Errorf("unexpected dependents of users: %s", from)
+	}
+}
+
+func TestValidateDepends(t *testing.T) {
+	tests := []struct {
+		spec string
+		err  string
+	}{
+		{spec: dependsSpec},
+		{spec: `{"name": "app", "services": [{"name": "a", "depends": "b"}]}`, err: "a: depends on unknown service b"},
+		{spec: `{"name": "app", "services": [{"name": "a", "depends": "a"}]}`, err: "a: can't depend on itself"},
+		{spec: `{"name": "app", "services": [{"name": "a"}], "jobs": [{"name": "j", "depends": "b"}]}`, err: "j: depends on unknown service b"},
+	}
+	for _, test := range tests {
+		err := validateDepends(parseService(t, test.spec))
+		if len(test.err) == 0 {
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("expected an error containing %q, got %v", test.err, err)
+		}
+	}
+}
+
+func TestKubernetesDependencyEnv(t *testing.T) {
+	svc := parseService(t, dependsSpec)
+	env, err := dependencyEnvVars(svc, "compute, users")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	vars := envMap(env)
+	expected := map[string]string{
+		"COMPUTE_SERVICE_HOST":  "compute.default",
+		"USERS_SERVICE_HOST":    "users-sharder.default",
+		"USERS_SHARD_ADDRESSES": "users-0.users:80,users-1.users:80",
+	}
+	for name, value := range expected {
+		if vars[name] != value {
+			t.Errorf("%s = %q, expected %q", name, vars[name], value)
+		}
+	}
+}
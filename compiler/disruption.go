@@ -0,0 +1,49 @@
+package compiler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+)
+
+// disruptionBudget returns the disruption budget of a service, or nil if it
+// has none. Sharded services default to disrupting one shard at a time, so
+// that a node drain can't take out several shards of the data together.
+func disruptionBudget(service *models.ServiceSpecification) *models.DisruptionBudget {
+	if service.DisruptionBudget != nil {
+		return service.DisruptionBudget
+	}
+	if service.ShardSpec != nil {
+		return &models.DisruptionBudget{
+			MaxUnavailable: "1",
+		}
+	}
+	return nil
+}
+
+// validateDisruptionBudget checks that a budget sets exactly one limit,
+// with percentages of at most 100%.
+func validateDisruptionBudget(service *models.ServiceSpecification) error {
+	budget := service.DisruptionBudget
+	if budget == nil {
+		return nil
+	}
+	if err := budget.Validate(strfmt.Default); err != nil {
+		return fmt.Errorf("%s: %v", *service.Name, err)
+	}
+	if (len(budget.MinAvailable) > 0) == (len(budget.MaxUnavailable) > 0) {
+		return fmt.Errorf("%s: disruption budget needs exactly one of minAvailable and maxUnavailable", *service.Name)
+	}
+	for _, value := range []string{budget.MinAvailable, budget.MaxUnavailable} {
+		if !strings.HasSuffix(value, "%") {
+			continue
+		}
+		if percent, _ := strconv.Atoi(strings.TrimSuffix(value, "%")); percent > 100 {
+			return fmt.Errorf("%s: disruption budget %s is more than 100%%", *service.Name, value)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package compiler
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPodDisruptionBudget(t *testing.T) {
+	tests := []struct {
+		name           string
+		service        string
+		minAvailable   string
+		maxUnavailable string
+		none           bool
+	}{
+		{name: "none", service: `{"name": "web"}`, none: true},
+		{name: "min available", service: `{"name": "web", "disruptionBudget": {"minAvailable": "2"}}`, minAvailable: "2"},
+		{name: "max unavailable percentage", service: `{"name": "web", "disruptionBudget": {"maxUnavailable": "25%"}}`, maxUnavailable: "25%"},
+		{name: "sharded default", service: `{"name": "users", "shardSpec": {"shards": 3}}`, maxUnavailable: "1"},
+		{name: "sharded override", service: `{"name": "users", "shardSpec": {"shards": 3}, "disruptionBudget": {"minAvailable": "50%"}}`, minAvailable: "50%"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := parseService(t, `{"name": "app", "services": [`+test.service+`]}`).Services[0]
+			pdb := podDisruptionBudget(service)
+			if test.none {
+				if pdb != nil {
+					t.Errorf("expected no budget, got %v", pdb)
+				}
+				return
+			}
+			if pdb == nil {
+				t.Fatalf("expected a budget")
+			}
+			if pdb.Name != *service.Name || pdb.Spec.Selector.MatchLabels["app"] != *service.Name {
+				t.Errorf("unexpected name %s or selector %v", pdb.Name, pdb.Spec.Selector)
+			}
+			minAvailable, maxUnavailable := "", ""
+			if pdb.Spec.MinAvailable != nil {
+				minAvailable = pdb.Spec.MinAvailable.String()
+			}
+			if pdb.Spec.MaxUnavailable != nil {
+				maxUnavailable = pdb.Spec.MaxUnavailable.String()
+			}
+			if minAvailable != test.minAvailable || maxUnavailable != test.maxUnavailable {
+				t.Errorf("got minAvailable %q and maxUnavailable %q, expected %q and %q", minAvailable, maxUnavailable, test.minAvailable, test.maxUnavailable)
+			}
+		})
+	}
+}
+
+func TestValidateDisruptionBudget(t *testing.T) {
+	tests := []struct {
+		budget string
+		err    string
+	}{
+		{budget: ``},
+		{budget: `, "disruptionBudget": {"minAvailable": "1"}`},
+		{budget: `, "disruptionBudget": {"maxUnavailable": "100%"}`},
+		{budget: `, "disruptionBudget": {}`, err: "exactly one of"},
+		{budget: `, "disruptionBudget": {"minAvailable": "1", "maxUnavailable": "1"}`, err: "exactly one of"},
+		{budget: `, "disruptionBudget": {"maxUnavailable": "101%"}`, err: "more than 100%"},
+		{budget: `, "disruptionBudget": {"minAvailable": "-1"}`, err: "web:"},
+	}
+	for _, test := range tests {
+		service := parseService(t, `{"name": "app", "services": [{"name": "web"`+test.budget+`}]}`).Services[0]
+		err := validateDisruptionBudget(service)
+		if len(test.err) == 0 {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", test.budget, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: expected an error containing %q, got %v", test.budget, test.err, err)
+		}
+	}
+}
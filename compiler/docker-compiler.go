@@ -3,6 +3,11 @@ package compiler
 import (
 	"fmt"
 	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/metaparticle-io/metaparticle-ast/models"
 )
@@ -32,35 +37,210 @@ func (d *dockerCompiler) Delete(opts *CompilerOptions, svc *models.Service) (Pla
 }
 
 func (d *dockerPlan) Execute(dryrun bool) error {
+	if hasIngress(d.service) {
+		return fmt.Errorf("docker runtime doesn't support hostname or path routing")
+	}
+	if err := validateDepends(d.service); err != nil {
+		return err
+	}
+	if err := validateImages(d.service); err != nil {
+		return err
+	}
+	if err := d.login(dryrun); err != nil {
+		return err
+	}
+	if err := createNetwork(d.opts.writer(), *d.service.Name, dryrun); err != nil {
+		return err
+	}
 	for ix := range d.service.Services {
 		if err := d.runService(d.service.Services[ix], d.service.Serve, dryrun); err != nil {
 			return err
 		}
 	}
+	for ix := range d.service.Jobs {
+		if err := d.runJob(d.service.Jobs[ix], dryrun); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// createNetwork creates the network the containers of an application share,
+// so that they can reach each other by container name.
+func createNetwork(out io.Writer, name string, dryrun bool) error {
+	if !dryrun {
+		if _, err := executeCommandOutput([]string{"docker", "network", "inspect", name}); err == nil {
+			// already exists
+			return nil
+		}
+	}
+	return executeCommand(out, []string{"docker", "network", "create", name}, dryrun)
+}
+
+// login logs in to the registries of the service that have a username,
+// the password is passed on stdin so it doesn't show up in the process list.
+func (d *dockerPlan) login(dryrun bool) error {
+	for _, registry := range d.service.Registries {
+		if len(registry.Username) == 0 {
+			continue
+		}
+		password, err := registryPassword(registry, dryrun)
+		if err != nil {
+			return err
+		}
+		cmd := []string{"docker", "login", "--username", registry.Username, "--password-stdin", *registry.Server}
+		if err := executeCommandInput(d.opts.writer(), cmd, password, dryrun); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dockerHost returns the address of a container on the application network.
+func dockerHost(service *models.ServiceSpecification) (string, error) {
+	return *service.Name, nil
+}
+
 func (d *dockerPlan) runService(spec *models.ServiceSpecification, serve *models.ServeSpecification, dryrun bool) error {
 	if spec.Replicas > 1 || spec.ShardSpec != nil {
 		return fmt.Errorf("docker runtime doesn't support replication or sharding")
 	}
 	image := *spec.Containers[0].Image
-	cmd := []string{"docker", "run", "--name", *spec.Name, "-d"}
+	cmd := []string{"docker", "run", "--name", *spec.Name, "--network", *d.service.Name, "-d"}
 
 	for _, port := range spec.Ports {
 		cmd = append(cmd, "-p", fmt.Sprintf("%d:%d", *port.Number, *port.Number))
 	}
 
-	if len(spec.Containers[0].Env) > 0 {
-		cmd = append(cmd, "-e")
-		for _, env := range spec.Containers[0].Env {
-			cmd = append(cmd, fmt.Sprintf("%s=%s", *env.Name, *env.Value))
-		}
+	if err := validateSecurity(*spec.Name, spec.SecurityContext); err != nil {
+		return err
+	}
+	securityArgs, err := dockerSecurityArgs(spec.SecurityContext)
+	if err != nil {
+		return fmt.Errorf("%s: %v", *spec.Name, err)
 	}
+	cmd = append(cmd, securityArgs...)
+
+	env, err := dependencyEnv(d.service, spec.Depends, dockerHost)
+	if err != nil {
+		return err
+	}
+	cmd = append(cmd, dockerEnvArgs(env, spec.Containers[0])...)
 
 	cmd = append(cmd, image)
 
-	return executeCommand(cmd, dryrun)
+	if err := dockerPull(d.opts.writer(), spec.Containers[0], dryrun); err != nil {
+		return err
+	}
+
+	return executeCommand(d.opts.writer(), cmd, dryrun)
+}
+
+// runJob runs a container for every completion of a job. Docker restarts
+// failed containers up to backoffLimit times, deadlines aren't supported.
+func (d *dockerPlan) runJob(job *models.JobSpecification, dryrun bool) error {
+	if err := validateJob(job); err != nil {
+		return err
+	}
+	image := *job.Containers[0].Image
+	env, err := dependencyEnv(d.service, job.Depends, dockerHost)
+	if err != nil {
+		return err
+	}
+
+	restart := "no"
+	if jobRestartPolicy(job) == models.JobSpecificationRestartPolicyOnFailure {
+		restart = "on-failure"
+		if job.BackoffLimit != nil {
+			// on-failure:0 would retry forever
+			restart = fmt.Sprintf("on-failure:%d", *job.BackoffLimit)
+			if *job.BackoffLimit == 0 {
+				restart = "no"
+			}
+		}
+	}
+
+	if err := validateSecurity(*job.Name, job.SecurityContext); err != nil {
+		return err
+	}
+	securityArgs, err := dockerSecurityArgs(job.SecurityContext)
+	if err != nil {
+		return fmt.Errorf("%s: %v", *job.Name, err)
+	}
+
+	if err := dockerPull(d.opts.writer(), job.Containers[0], dryrun); err != nil {
+		return err
+	}
+
+	for ix := int32(0); ix < jobCompletions(job); ix++ {
+		cmd := []string{"docker", "run", "--name", jobInstanceName(job, ix), "--network", *d.service.Name, "-d", "--restart", restart}
+		cmd = append(cmd, securityArgs...)
+		cmd = append(cmd, dockerEnvArgs(append(env, jobIndexEnv(job, ix)...), job.Containers[0])...)
+		cmd = append(cmd, image)
+		if err := executeCommand(d.opts.writer(), cmd, dryrun); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dockerEnvArgs returns the docker run flags that set the environment of a
+// container, its own environment comes last so that it takes precedence.
+func dockerEnvArgs(env []envVar, container *models.Container) []string {
+	args := []string{}
+	for _, e := range env {
+		args = append(args, "-e", fmt.Sprintf("%s=%s", e.name, e.value))
+	}
+	for _, env := range container.Env {
+		args = append(args, "-e", fmt.Sprintf("%s=%s", *env.Name, *env.Value))
+	}
+	return args
+}
+
+// dockerSecurityArgs returns the docker run flags for a security context.
+// Docker has no equivalent of runAsNonRoot, set runAsUser instead.
+func dockerSecurityArgs(security *models.SecurityContext) ([]string, error) {
+	args := []string{}
+	if security == nil {
+		return args, nil
+	}
+	if security.RunAsUser != nil {
+		user := strconv.FormatInt(*security.RunAsUser, 10)
+		if security.RunAsGroup != nil {
+			user += ":" + strconv.FormatInt(*security.RunAsGroup, 10)
+		}
+		args = append(args, "--user", user)
+	} else if security.RunAsGroup != nil {
+		return nil, fmt.Errorf("docker runtime needs runAsUser to set runAsGroup")
+	}
+	if security.FsGroup != nil {
+		args = append(args, "--group-add", strconv.FormatInt(*security.FsGroup, 10))
+	}
+	if security.ReadOnlyRootFilesystem {
+		args = append(args, "--read-only")
+	}
+	if security.AllowPrivilegeEscalation != nil && !*security.AllowPrivilegeEscalation {
+		args = append(args, "--security-opt", "no-new-privileges")
+	}
+	for _, capability := range security.DropCapabilities {
+		args = append(args, "--cap-drop", capability)
+	}
+	switch {
+	case security.SeccompProfile == seccompUnconfined:
+		args = append(args, "--security-opt", "seccomp=unconfined")
+	case strings.HasPrefix(security.SeccompProfile, seccompLocalhost):
+		args = append(args, "--security-opt", "seccomp="+strings.TrimPrefix(security.SeccompProfile, seccompLocalhost))
+	}
+	return args, nil
+}
+
+// dockerPull pulls the image of a container if its pull policy asks for it,
+// docker run only pulls missing images.
+func dockerPull(out io.Writer, container *models.Container, dryrun bool) error {
+	if container.ImagePullPolicy != models.ContainerImagePullPolicyAlways {
+		return nil
+	}
+	return executeCommand(out, []string{"docker", "pull", *container.Image}, dryrun)
 }
 
 func (d *dockerPlan) Dump(dir string) error {
@@ -73,12 +253,32 @@ func (d *dockerDeletePlan) Execute(dryrun bool) error {
 			return err
 		}
 	}
-	return nil
+	for _, job := range d.service.Jobs {
+		for ix := int32(0); ix < jobCompletions(job); ix++ {
+			if err := executeCommand(d.opts.writer(), []string{"docker", "rm", "-f", jobInstanceName(job, ix)}, dryrun); err != nil {
+				return err
+			}
+		}
+	}
+	return deleteNetwork(d.opts.writer(), *d.service.Name, dryrun)
+}
+
+// deleteNetwork removes the network of an application, if it has one. Apps
+// deployed before they had a network, or whose network is already gone,
+// are torn down all the same.
+func deleteNetwork(out io.Writer, name string, dryrun bool) error {
+	if !dryrun {
+		if _, err := executeCommandOutput([]string{"docker", "network", "inspect", name}); err != nil {
+			// doesn't exist
+			return nil
+		}
+	}
+	return executeCommand(out, []string{"docker", "network", "rm", name}, dryrun)
 }
 
 func (d *dockerDeletePlan) deleteService(spec *models.ServiceSpecification, dryrun bool) error {
 	cmd := []string{"docker", "rm", "-f", *spec.Name}
-	return executeCommand(cmd, dryrun)
+	return executeCommand(d.opts.writer(), cmd, dryrun)
 }
 
 func (d *dockerDeletePlan) Dump(dir string) error {
@@ -89,3 +289,138 @@ func (d *dockerCompiler) Logs(svc *models.Service, stdout, stderr io.Writer) err
 	cmd := []string{"docker", "logs", *svc.Services[0].Name}
 	return executeCommandStreaming(cmd, stdout, stderr)
 }
+
+func (d *dockerCompiler) Wait(svc *models.Service, timeout time.Duration, stdout, stderr io.Writer) ([]*JobResult, error) {
+	// the logs are followed until the jobs finished, one line at a time so
+	// that the lines of different runs don't interleave
+	stop := make(chan struct{})
+	var mutex sync.Mutex
+	var wait sync.WaitGroup
+	defer func() {
+		close(stop)
+		wait.Wait()
+	}()
+	for _, job := range svc.Jobs {
+		for ix := int32(0); ix < jobCompletions(job); ix++ {
+			wait.Add(1)
+			go func(name string) {
+				defer wait.Done()
+				err := executeCommandLines([]string{"docker", "logs", "-f", name}, stop, func(line string) {
+					mutex.Lock()
+					defer mutex.Unlock()
+					fmt.Fprintf(stdout, "%s %s\n", name, strings.TrimRight(line, "\r\n"))
+				})
+				if err != nil {
+					mutex.Lock()
+					defer mutex.Unlock()
+					fmt.Fprintf(stderr, "Error while following the logs of %s: %v\n", name, err)
+				}
+			}(jobInstanceName(job, ix))
+		}
+	}
+	return pollJobs(svc, timeout, func(job *models.JobSpecification, name string) (*JobResult, error) {
+		state, exitCode, err := dockerState(name)
+		if err != nil {
+			return nil, err
+		}
+		return dockerJobResult(*job.Name, name, state, exitCode), nil
+	})
+}
+
+// dockerJobResult returns the outcome of the container of a job run, or nil
+// while it runs. The containers are created by the deploy, so one that
+// doesn't exist was removed and its outcome is unknown.
+func dockerJobResult(job string, name string, state string, exitCode int) *JobResult {
+	switch state {
+	case "":
+		return &JobResult{Job: job, Name: name, Reason: removedReason}
+	case "exited":
+		return &JobResult{
+			Job:       job,
+			Name:      name,
+			Succeeded: exitCode == 0,
+			ExitCode:  int32(exitCode),
+		}
+	}
+	return nil
+}
+
+// dockerState returns the state of a container, the state is empty if the
+// container doesn't exist.
+func dockerState(name string) (state string, exitCode int, err error) {
+	out, err := executeCommandOutput([]string{"docker", "inspect", "-f", "{{.State.Status}} {{.State.ExitCode}}", name})
+	if _, failed := err.(*exec.ExitError); failed {
+		// docker inspect fails for missing containers
+		return "", 0, nil
+	}
+	if err != nil {
+		return "", 0, err
+	}
+	fields := strings.Fields(out)
+	if len(fields) != 2 {
+		return "", 0, fmt.Errorf("unexpected state of %s: %s", name, out)
+	}
+	exitCode, err = strconv.Atoi(fields[1])
+	return fields[0], exitCode, err
+}
+
+func (d *dockerCompiler) Status(svc *models.Service) (*models.Status, error) {
+	status := newStatus(svc, "docker")
+	for _, service := range svc.Services {
+		serviceStatus := newServiceStatus(service)
+		// docker runs a single container for every service
+		serviceStatus.Replicas = 1
+		state, exitCode, err := dockerState(*service.Name)
+		if err != nil {
+			return nil, err
+		}
+		switch state {
+		case "":
+			serviceStatus.Failures = addFailure(serviceStatus.Failures, fmt.Sprintf("container %s doesn't exist", *service.Name))
+		case "running":
+			serviceStatus.ReadyReplicas = 1
+		default:
+			serviceStatus.Failures = addFailure(serviceStatus.Failures, fmt.Sprintf("%s: %s (exit code %d)", *service.Name, state, exitCode))
+		}
+		if isPublic(svc, *service.Name) {
+			serviceStatus.Endpoints = portEndpoints("localhost", service.Ports)
+		}
+		status.Services = append(status.Services, serviceStatus)
+	}
+	for _, job := range svc.Jobs {
+		jobStatus := newJobStatus(job)
+		for ix := int32(0); ix < jobCompletions(job); ix++ {
+			name := jobInstanceName(job, ix)
+			state, exitCode, err := dockerState(name)
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case state == "":
+				jobStatus.Failures = addFailure(jobStatus.Failures, fmt.Sprintf("container %s doesn't exist", name))
+			case state == "exited" && exitCode == 0:
+				jobStatus.Succeeded++
+			case state == "exited" || state == "dead":
+				jobStatus.Failed++
+				jobStatus.Failures = addFailure(jobStatus.Failures, fmt.Sprintf("%s: %s (exit code %d)", name, state, exitCode))
+			default:
+				jobStatus.Active++
+			}
+		}
+		status.Jobs = append(status.Jobs, jobStatus)
+	}
+	return status, nil
+}
+
+func (d *dockerCompiler) StreamLogs(svc *models.Service, opts *LogOptions, stop <-chan struct{}, handle func(*models.LogEvent)) error {
+	return streamCommands(logSources(svc, opts), stop, handle, func(source logSource) []string {
+		cmd := []string{"docker", "logs", "--timestamps"}
+		if opts.Follow {
+			cmd = append(cmd, "--follow")
+		}
+		if !opts.Since.IsZero() {
+			cmd = append(cmd, "--since", opts.Since.Format(time.RFC3339))
+		}
+		return append(cmd, source.container)
+	})
+}
@@ -0,0 +1,90 @@
+package compiler
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/metaparticle-io/metaparticle-ast/models"
+)
+
+func TestDockerJobResult(t *testing.T) {
+	tests := []struct {
+		name      string
+		state     string
+		exitCode  int
+		finished  bool
+		succeeded bool
+		reason    string
+	}{
+		{name: "running", state: "running"},
+		{name: "created", state: "created"},
+		{name: "succeeded", state: "exited", finished: true, succeeded: true},
+		{name: "failed", state: "exited", exitCode: 2, finished: true},
+		{name: "removed", finished: true, reason: removedReason},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := dockerJobResult("migrate", "migrate", test.state, test.exitCode)
+			if !test.finished {
+				if result != nil {
+					t.Errorf("expected the run to go on, got %v", result)
+				}
+				return
+			}
+			if result == nil {
+				t.Fatalf("expected the run to be finished")
+			}
+			if result.Succeeded != test.succeeded || result.ExitCode != int32(test.exitCode) || result.Reason != test.reason {
+				t.Errorf("unexpected result %v", result)
+			}
+		})
+	}
+}
+
+func TestPollJobsRemoved(t *testing.T) {
+	svc := parseService(t, `{"name": "app", "jobs": [{"name": "migrate", "containers": [{"image": "migrate"}]}, {"name": "seed", "containers": [{"image": "seed"}]}]}`)
+	states := map[string]string{"migrate": "exited", "seed": ""}
+	results, err := pollJobs(svc, time.Second, func(job *models.JobSpecification, name string) (*JobResult, error) {
+		return dockerJobResult(*job.Name, name, states[name], 0), nil
+	})
+	if len(results) != 2 || !results[0].Succeeded || results[1].Succeeded {
+		t.Errorf("unexpected results %v", results)
+	}
+	if err == nil || !strings.Contains(err.Error(), "seed ("+removedReason+")") {
+		t.Errorf("expected the removed run to fail the wait, got %v", err)
+	}
+}
+
+func TestDockerDeletePlan(t *testing.T) {
+	svc := parseService(t, `{"name": "app", "services": [{"name": "web"}], "jobs": [{"name": "migrate", "replicas": 2, "containers": [{"image": "migrate"}]}]}`)
+	out := newRecorder()
+	plan, _ := NewDockerCompiler().Delete(&CompilerOptions{Output: out}, svc)
+	if err := plan.Execute(true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{
+		"docker rm -f web",
+		"docker rm -f migrate-0",
+		"docker rm -f migrate-1",
+		"docker network rm app",
+	}
+	commands := []string{}
+	for _, cmd := range out.commands {
+		commands = append(commands, strings.Join(cmd, " "))
+	}
+	if strings.Join(commands, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("expected %v, got %v", expected, commands)
+	}
+
+	// an app without a network, or whose network was removed, is torn down
+	missing := parseService(t, `{"name": "metaparticle-test-missing-network"}`)
+	out = newRecorder()
+	plan, _ = NewDockerCompiler().Delete(&CompilerOptions{Output: out}, missing)
+	if err := plan.Execute(false); err != nil {
+		t.Errorf("expected a missing network to be skipped, got %v", err)
+	}
+	if len(out.commands) != 0 {
+		t.Errorf("expected no command, got %v", out.commands)
+	}
+}
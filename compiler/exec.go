@@ -1,20 +1,40 @@
 package compiler
 
 import (
+	"bufio"
 	"fmt"
 	"io"
-	"os"
 	"os/exec"
+	"strings"
 )
 
-func executeCommand(cmd []string, dryrun bool) error {
+// dryRunCommand reports a command that a dry run would execute.
+func dryRunCommand(out io.Writer, cmd []string) {
+	if recorder, ok := out.(StepRecorder); ok {
+		recorder.Command(cmd)
+		return
+	}
+	fmt.Fprintf(out, "Would execute: %v\n", cmd)
+}
+
+// dryRunNote reports anything else that a dry run would have done.
+func dryRunNote(out io.Writer, format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	if recorder, ok := out.(StepRecorder); ok {
+		recorder.Note(message)
+		return
+	}
+	fmt.Fprintln(out, message)
+}
+
+func executeCommand(out io.Writer, cmd []string, dryrun bool) error {
 	if dryrun {
-		fmt.Printf("Would execute: %v\n", cmd)
+		dryRunCommand(out, cmd)
 		return nil
 	}
 	c := exec.Command(cmd[0], cmd[1:]...)
 	data, err := c.CombinedOutput()
-	os.Stdout.Write(data)
+	out.Write(data)
 	return err
 }
 
@@ -24,3 +44,67 @@ func executeCommandStreaming(cmd []string, stdout, stderr io.Writer) error {
 	c.Stdout = stdout
 	return c.Run()
 }
+
+// executeCommandLines runs a command and passes every line that it writes,
+// to stdout or stderr, to line. The command is killed when stop is closed.
+func executeCommandLines(cmd []string, stop <-chan struct{}, line func(string)) error {
+	reader, writer := io.Pipe()
+	c := exec.Command(cmd[0], cmd[1:]...)
+	c.Stdout = writer
+	c.Stderr = writer
+	if err := c.Start(); err != nil {
+		return err
+	}
+	finished := make(chan struct{})
+	defer close(finished)
+	go func() {
+		select {
+		case <-stop:
+			c.Process.Kill()
+		case <-finished:
+		}
+	}()
+	result := make(chan error, 1)
+	go func() {
+		err := c.Wait()
+		writer.Close()
+		result <- err
+	}()
+
+	r := bufio.NewReader(reader)
+	for {
+		s, err := r.ReadString('\n')
+		if len(s) > 0 {
+			line(s)
+		}
+		if err != nil {
+			break
+		}
+	}
+	err := <-result
+	select {
+	case <-stop:
+		// the command was killed
+		return nil
+	default:
+		return err
+	}
+}
+
+func executeCommandOutput(cmd []string) (string, error) {
+	c := exec.Command(cmd[0], cmd[1:]...)
+	data, err := c.Output()
+	return strings.TrimSpace(string(data)), err
+}
+
+func executeCommandInput(out io.Writer, cmd []string, input string, dryrun bool) error {
+	if dryrun {
+		dryRunCommand(out, cmd)
+		return nil
+	}
+	c := exec.Command(cmd[0], cmd[1:]...)
+	c.Stdin = strings.NewReader(input)
+	data, err := c.CombinedOutput()
+	out.Write(data)
+	return err
+}
@@ -0,0 +1,66 @@
+package compiler
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExecuteCommandLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		cmd      []string
+		stop     bool
+		expected []string
+		fails    bool
+	}{
+		{
+			name:     "output",
+			cmd:      []string{"sh", "-c", "echo one; echo two >&2; printf three"},
+			expected: []string{"one\n", "two\n", "three"},
+		},
+		{
+			name:     "stopped",
+			cmd:      []string{"sh", "-c", "echo started; exec sleep 60"},
+			stop:     true,
+			expected: []string{"started\n"},
+		},
+		{
+			name:  "failure",
+			cmd:   []string{"sh", "-c", "exit 3"},
+			fails: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stop := make(chan struct{})
+			lines := make(chan string, 10)
+			result := make(chan error, 1)
+			go func() {
+				result <- executeCommandLines(test.cmd, stop, func(line string) {
+					lines <- line
+				})
+			}()
+			got := []string{}
+			if test.stop {
+				got = append(got, <-lines)
+				close(stop)
+			}
+			select {
+			case err := <-result:
+				if test.fails != (err != nil) {
+					t.Errorf("unexpected error: %v", err)
+				}
+			case <-time.After(10 * time.Second):
+				t.Fatalf("the command wasn't stopped")
+			}
+			close(lines)
+			for line := range lines {
+				got = append(got, line)
+			}
+			if strings.Join(got, "|") != strings.Join(test.expected, "|") {
+				t.Errorf("got lines %q, expected %q", got, test.expected)
+			}
+		})
+	}
+}
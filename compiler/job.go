@@ -0,0 +1,59 @@
+package compiler
+
+import (
+	"fmt"
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+)
+
+// jobCompletions returns the number of successful runs a job needs.
+func jobCompletions(job *models.JobSpecification) int32 {
+	if job.Replicas > 0 {
+		return job.Replicas
+	}
+	return 1
+}
+
+// jobRestartPolicy returns what happens to a failed run of a job, by
+// default it is restarted.
+func jobRestartPolicy(job *models.JobSpecification) string {
+	if len(job.RestartPolicy) > 0 {
+		return job.RestartPolicy
+	}
+	return models.JobSpecificationRestartPolicyOnFailure
+}
+
+// jobInstanceName returns the name of the ix'th run of a job that is
+// executed as separate runs, i.e. an indexed job or a job on a backend
+// without completions.
+func jobInstanceName(job *models.JobSpecification, ix int32) string {
+	if jobCompletions(job) == 1 {
+		return *job.Name
+	}
+	return fmt.Sprintf("%s-%d", *job.Name, ix)
+}
+
+// jobIndexEnv returns the environment that tells the ix'th run of an
+// indexed job which part of the work it is responsible for.
+func jobIndexEnv(job *models.JobSpecification, ix int32) []envVar {
+	if !job.Indexed {
+		return nil
+	}
+	return []envVar{
+		envVar{"JOB_COMPLETION_INDEX", strconv.Itoa(int(ix))},
+		envVar{"JOB_COMPLETIONS", strconv.Itoa(int(jobCompletions(job)))},
+	}
+}
+
+// validateJob checks the execution settings of a job.
+func validateJob(job *models.JobSpecification) error {
+	if err := job.Validate(strfmt.Default); err != nil {
+		return fmt.Errorf("%s: %v", *job.Name, err)
+	}
+	if job.Indexed && job.Parallelism > 0 {
+		return fmt.Errorf("%s: indexed jobs run all completions at once, parallelism can't be set", *job.Name)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package compiler
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestKubernetesJobs(t *testing.T) {
+	tests := []struct {
+		name        string
+		job         string
+		names       []string
+		completions int32
+		parallelism int32
+		restart     string
+		index       []string
+	}{
+		{
+			name:        "defaults",
+			job:         `{"name": "migrate", "containers": [{"image": "migrate"}]}`,
+			names:       []string{"migrate"},
+			completions: 1,
+			restart:     "OnFailure",
+			index:       []string{""},
+		},
+		{
+			name:        "parallel",
+			job:         `{"name": "crawl", "replicas": 4, "parallelism": 2, "restartPolicy": "Never", "containers": [{"image": "crawl"}]}`,
+			names:       []string{"crawl"},
+			completions: 4,
+			parallelism: 2,
+			restart:     "Never",
+			index:       []string{""},
+		},
+		{
+			name:        "indexed",
+			job:         `{"name": "render", "replicas": 3, "indexed": true, "containers": [{"image": "render"}]}`,
+			names:       []string{"render-0", "render-1", "render-2"},
+			completions: 1,
+			restart:     "OnFailure",
+			index:       []string{"0", "1", "2"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			plan, _ := dryRunPlan(parseService(t, `{"name": "app", "jobs": [`+test.job+`]}`))
+			jobs, err := plan.jobs(plan.service.Jobs[0])
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(jobs) != len(test.names) {
+				t.Fatalf("expected %d jobs, got %d", len(test.names), len(jobs))
+			}
+			for ix, job := range jobs {
+				if job.Name != test.names[ix] {
+					t.Errorf("job %d is named %s, expected %s", ix, job.Name, test.names[ix])
+				}
+				if *job.Spec.Completions != test.completions {
+					t.Errorf("%s: completions = %d, expected %d", job.Name, *job.Spec.Completions, test.completions)
+				}
+				parallelism := int32(0)
+				if job.Spec.Parallelism != nil {
+					parallelism = *job.Spec.Parallelism
+				}
+				if parallelism != test.parallelism {
+					t.Errorf("%s: parallelism = %d, expected %d", job.Name, parallelism, test.parallelism)
+				}
+				if string(job.Spec.Template.Spec.RestartPolicy) != test.restart {
+					t.Errorf("%s: restart policy = %s, expected %s", job.Name, job.Spec.Template.Spec.RestartPolicy, test.restart)
+				}
+				env := envMap(job.Spec.Template.Spec.Containers[0].Env)
+				if env["JOB_COMPLETION_INDEX"] != test.index[ix] {
+					t.Errorf("%s: JOB_COMPLETION_INDEX = %q, expected %q", job.Name, env["JOB_COMPLETION_INDEX"], test.index[ix])
+				}
+			}
+		})
+	}
+}
+
+func TestValidateJob(t *testing.T) {
+	tests := []struct {
+		name string
+		job  string
+		err  string
+	}{
+		{name: "valid", job: `{"name": "migrate", "replicas": 2, "parallelism": 2, "restartPolicy": "Never", "containers": [{"image": "migrate"}]}`},
+		{name: "indexed", job: `{"name": "render", "replicas": 3, "indexed": true, "containers": [{"image": "render"}]}`},
+		{
+			name: "indexed with parallelism",
+			job:  `{"name": "render", "replicas": 3, "indexed": true, "parallelism": 2, "containers": [{"image": "render"}]}`,
+			err:  "parallelism can't be set",
+		},
+		{
+			name: "invalid restart policy",
+			job:  `{"name": "migrate", "restartPolicy": "Always", "containers": [{"image": "migrate"}]}`,
+			err:  "migrate:",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := parseService(t, `{"name": "app", "jobs": [`+test.job+`]}`)
+			err := validateJob(svc.Jobs[0])
+			if len(test.err) == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("expected an error containing %q, got %v", test.err, err)
+			}
+		})
+	}
+}
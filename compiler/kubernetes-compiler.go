@@ -15,7 +15,6 @@ import (
 	"sync"
 
 	"github.com/fatih/color"
-	"github.com/golang/glog"
 	"github.com/metaparticle-io/metaparticle-ast/ktail"
 	"github.com/metaparticle-io/metaparticle-ast/models"
 	apps_v1beta1 "k8s.io/api/apps/v1beta1"
@@ -24,6 +23,7 @@ import (
 	"k8s.io/api/extensions/v1beta1"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	"k8s.io/client-go/tools/clientcmd"
@@ -96,13 +96,22 @@ func (k *kubernetesPlan) deleteService(service *models.ServiceSpecification, cli
 }
 
 func (k *kubernetesPlan) deleteJob(job *models.JobSpecification, client *kubernetes.Clientset) error {
-	return client.BatchV1().Jobs("default").Delete(*job.Name, &meta.DeleteOptions{})
+	for _, name := range kubernetesJobNames(job) {
+		if k.dryrun {
+			dryRunNote(k.opts.writer(), "Would have deleted job %s", name)
+			continue
+		}
+		if err := client.BatchV1().Jobs("default").Delete(name, &meta.DeleteOptions{}); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (k *kubernetesPlan) deleteReplicatedService(service *models.ServiceSpecification, client *kubernetes.Clientset) error {
 	name := *service.Name
 	if k.dryrun {
-		glog.Infof("Would have deleted deployment and service %s\n", name)
+		dryRunNote(k.opts.writer(), "Would have deleted deployment and service %s", name)
 		return nil
 	}
 	if err := client.ExtensionsV1beta1().Deployments("default").Delete(name, deleteOptions); err != nil {
@@ -120,7 +129,7 @@ func (k *kubernetesPlan) deleteShardedService(service *models.ServiceSpecificati
 	shardName := makeSharderName(name)
 
 	if k.dryrun {
-		glog.Infof("Would have deleted deployment and service %s &%s\n", name, shardName)
+		dryRunNote(k.opts.writer(), "Would have deleted deployment and service %s &%s", name, shardName)
 		return nil
 	}
 
@@ -136,32 +145,40 @@ func (k *kubernetesPlan) deleteShardedService(service *models.ServiceSpecificati
 	return client.CoreV1().Services("default").Delete(name, deleteOptions)
 }
 
-func containers(service *models.ServiceSpecification) []v1.Container {
+// containers returns the containers of a service, dependencyEnv comes
+// first so that the container's own environment can override it.
+func containers(service *models.ServiceSpecification, dependencyEnv []v1.EnvVar) []v1.Container {
 	containers := []v1.Container{}
 	for ix, c := range service.Containers {
 		containers = append(containers, v1.Container{
-			Name:  fmt.Sprintf("%s-%d", *service.Name, ix),
-			Image: *c.Image,
-			Env:   envvars(c),
+			Name:            fmt.Sprintf("%s-%d", *service.Name, ix),
+			Image:           *c.Image,
+			ImagePullPolicy: v1.PullPolicy(c.ImagePullPolicy),
+			Env:             append(append([]v1.EnvVar{}, dependencyEnv...), envvars(c)...),
 		})
 	}
 	return containers
 }
 
-func containersForJob(job *models.JobSpecification) []v1.Container {
+func containersForJob(job *models.JobSpecification, dependencyEnv []v1.EnvVar) []v1.Container {
 	containers := []v1.Container{}
 	for ix, c := range job.Containers {
 		containers = append(containers, v1.Container{
-			Name:  fmt.Sprintf("%s-%d", *job.Name, ix),
-			Image: *c.Image,
-			Env:   envvars(c),
+			Name:            fmt.Sprintf("%s-%d", *job.Name, ix),
+			Image:           *c.Image,
+			ImagePullPolicy: v1.PullPolicy(c.ImagePullPolicy),
+			Env:             append(append([]v1.EnvVar{}, dependencyEnv...), envvars(c)...),
 		})
 	}
 	return containers
 }
 
-func (k *kubernetesPlan) deploy(service *models.ServiceSpecification, client *kubernetes.Clientset) {
+func (k *kubernetesPlan) deploy(service *models.ServiceSpecification, client *kubernetes.Clientset) error {
 	name := *service.Name
+	env, err := dependencyEnvVars(k.service, service.Depends)
+	if err != nil {
+		return err
+	}
 
 	deployment := &v1beta1.Deployment{
 		ObjectMeta: meta.ObjectMeta{
@@ -181,24 +198,31 @@ func (k *kubernetesPlan) deploy(service *models.ServiceSpecification, client *ku
 					},
 				},
 				Spec: v1.PodSpec{
-					Containers: containers(service),
+					Containers: containers(service, env),
 				},
 			},
 		},
 	}
 
+	applyPlacement(&deployment.Spec.Template.Spec, service.Placement, name, false)
+	deployment.Spec.Template.Spec.ImagePullSecrets = imagePullSecrets(k.service)
+	applySecurity(&deployment.Spec.Template, service.SecurityContext, service.ServiceAccountName)
+
 	k.output(deployment, name+"-deploy")
 	if k.dryrun {
-		return
+		return nil
 	}
 
-	if _, err := client.ExtensionsV1beta1().Deployments("default").Create(deployment); err != nil {
-		log.Fatalf(err.Error())
-	}
+	_, err = client.ExtensionsV1beta1().Deployments("default").Create(deployment)
+	return err
 }
 
-func (k *kubernetesPlan) deployStateful(service *models.ServiceSpecification, client *kubernetes.Clientset) {
+func (k *kubernetesPlan) deployStateful(service *models.ServiceSpecification, client *kubernetes.Clientset) error {
 	name := *service.Name
+	env, err := dependencyEnvVars(k.service, service.Depends)
+	if err != nil {
+		return err
+	}
 
 	deployment := &apps_v1beta1.StatefulSet{
 		ObjectMeta: meta.ObjectMeta{
@@ -219,26 +243,31 @@ func (k *kubernetesPlan) deployStateful(service *models.ServiceSpecification, cl
 					},
 				},
 				Spec: v1.PodSpec{
-					Containers: containers(service),
+					Containers: containers(service, env),
 				},
 			},
 		},
 	}
 
+	applyPlacement(&deployment.Spec.Template.Spec, service.Placement, name, service.ShardSpec.SpreadAcrossZones)
+	deployment.Spec.Template.Spec.ImagePullSecrets = imagePullSecrets(k.service)
+	applySecurity(&deployment.Spec.Template, service.SecurityContext, service.ServiceAccountName)
+
 	k.output(deployment, name+"-stateful-set")
 	if !k.dryrun {
 		if _, err := client.AppsV1beta1().StatefulSets("default").Create(deployment); err != nil {
-			log.Fatalf(err.Error())
+			return err
 		}
 	}
 
 	name = makeSharderName(name)
+	replicas := sharderReplicas(service.ShardSpec)
 	shardDeployment := &v1beta1.Deployment{
 		ObjectMeta: meta.ObjectMeta{
 			Name: name,
 		},
 		Spec: v1beta1.DeploymentSpec{
-			Replicas: &service.ShardSpec.Shards,
+			Replicas: &replicas,
 			Selector: &meta.LabelSelector{
 				MatchLabels: map[string]string{
 					"app": name,
@@ -254,17 +283,9 @@ func (k *kubernetesPlan) deployStateful(service *models.ServiceSpecification, cl
 					Containers: []v1.Container{
 						v1.Container{
 							Name:  "sharder",
-							Image: "brendanburns/sharder",
-							Env: []v1.EnvVar{
-								v1.EnvVar{
-									Name:  "SHARD_ADDRESSES",
-									Value: getShardAddresses(service),
-								},
-								v1.EnvVar{
-									Name:  "SERVER_ADDRESS",
-									Value: "0.0.0.0:8080",
-								},
-							},
+							Image: sharderImage(service.ShardSpec),
+							Env:   sharderEnv(service),
+							Ports: getSharderContainerPorts(service),
 						},
 					},
 				},
@@ -272,14 +293,17 @@ func (k *kubernetesPlan) deployStateful(service *models.ServiceSpecification, cl
 		},
 	}
 
+	applyPlacement(&shardDeployment.Spec.Template.Spec, service.Placement, name, false)
+	shardDeployment.Spec.Template.Spec.ImagePullSecrets = imagePullSecrets(k.service)
+	applySecurity(&shardDeployment.Spec.Template, service.SecurityContext, service.ServiceAccountName)
+
 	k.output(shardDeployment, name+"shard-router")
 	if k.dryrun {
-		return
+		return nil
 	}
 
-	if _, err := client.ExtensionsV1beta1().Deployments("default").Create(shardDeployment); err != nil {
-		log.Fatalf(err.Error())
-	}
+	_, err = client.ExtensionsV1beta1().Deployments("default").Create(shardDeployment)
+	return err
 }
 
 func getPorts(service *models.ServiceSpecification) []v1.ServicePort {
@@ -287,6 +311,7 @@ func getPorts(service *models.ServiceSpecification) []v1.ServicePort {
 	for px := range service.Ports {
 		port := service.Ports[px]
 		ports = append(ports, v1.ServicePort{
+			Name:     servicePortName(port, len(service.Ports)),
 			Port:     *port.Number,
 			Protocol: "TCP",
 		})
@@ -294,7 +319,43 @@ func getPorts(service *models.ServiceSpecification) []v1.ServicePort {
 	return ports
 }
 
-func (k *kubernetesPlan) createLoadBalancedService(service *models.ServiceSpecification, public bool, client *kubernetes.Clientset) {
+// servicePortName returns the name of a service port, Kubernetes requires
+// every port of a multi-port service to be named.
+func servicePortName(port *models.ServicePort, count int) string {
+	if len(port.Name) > 0 || count == 1 {
+		return port.Name
+	}
+	return fmt.Sprintf("port-%d", *port.Number)
+}
+
+// getSharderPorts returns the ports of the router service, which forwards
+// each sharded port to the port the sharder listens on for it.
+func getSharderPorts(service *models.ServiceSpecification) []v1.ServicePort {
+	sharded := shardedPorts(service)
+	ports := []v1.ServicePort{}
+	for ix, port := range sharded {
+		ports = append(ports, v1.ServicePort{
+			Name:       servicePortName(port, len(sharded)),
+			Port:       *port.Number,
+			TargetPort: intstr.FromInt(int(sharderListenPort(service, ix))),
+			Protocol:   "TCP",
+		})
+	}
+	return ports
+}
+
+// getSharderContainerPorts returns the ports the sharder listens on.
+func getSharderContainerPorts(service *models.ServiceSpecification) []v1.ContainerPort {
+	ports := []v1.ContainerPort{}
+	for ix := range shardedPorts(service) {
+		ports = append(ports, v1.ContainerPort{
+			ContainerPort: sharderListenPort(service, ix),
+		})
+	}
+	return ports
+}
+
+func (k *kubernetesPlan) createLoadBalancedService(service *models.ServiceSpecification, public bool, client *kubernetes.Clientset) error {
 	name := *service.Name
 
 	svc := &v1.Service{
@@ -311,22 +372,22 @@ func (k *kubernetesPlan) createLoadBalancedService(service *models.ServiceSpecif
 
 	if public {
 		svc.Spec.Type = "LoadBalancer"
+		// keep the client address so the network policy can tell public
+		// traffic from traffic within the cluster
+		svc.Spec.ExternalTrafficPolicy = v1.ServiceExternalTrafficPolicyTypeLocal
 	}
 
 	k.output(svc, name+"-load-balancer")
 	if k.dryrun {
-		return
+		return nil
 	}
 
-	if _, err := client.CoreV1().Services("default").Create(svc); err != nil {
-		log.Fatalf(err.Error())
-	}
+	_, err := client.CoreV1().Services("default").Create(svc)
+	return err
 }
 
-func getShardAddresses(service *models.ServiceSpecification) string {
+func getShardAddresses(service *models.ServiceSpecification, port int32) string {
 	name := *service.Name
-	// TODO: multi-port here?
-	port := int(*service.Ports[0].Number)
 	pieces := []string{}
 	for ix := 0; int32(ix) < service.ShardSpec.Shards; ix++ {
 		pieces = append(pieces, fmt.Sprintf("%s-%d.%s:%d", name, ix, name, port))
@@ -334,7 +395,77 @@ func getShardAddresses(service *models.ServiceSpecification) string {
 	return strings.Join(pieces, ",")
 }
 
-func (k *kubernetesPlan) createStatefulService(service *models.ServiceSpecification, public bool, client *kubernetes.Clientset) {
+// sharderEnv returns the environment that configures the sharder, including
+// the rules for extracting the shard key from a request. The first sharded
+// port is configured with SHARD_ADDRESSES and SERVER_ADDRESS, if there is more
+// than one, SHARD_PORTS lists a key for each port and the addresses for that
+// port are in SHARD_ADDRESSES_<key> and SERVER_ADDRESS_<key>.
+func sharderEnv(service *models.ServiceSpecification) []v1.EnvVar {
+	sharded := shardedPorts(service)
+	env := []v1.EnvVar{
+		v1.EnvVar{
+			Name:  "SHARD_ADDRESSES",
+			Value: getShardAddresses(service, *sharded[0].Number),
+		},
+		v1.EnvVar{
+			Name:  "SERVER_ADDRESS",
+			Value: fmt.Sprintf("0.0.0.0:%d", sharderListenPort(service, 0)),
+		},
+	}
+	if len(sharded) > 1 {
+		keys := []string{}
+		for ix, port := range sharded {
+			key := shardPortKey(port)
+			keys = append(keys, key)
+			env = append(env, v1.EnvVar{
+				Name:  "SHARD_ADDRESSES_" + key,
+				Value: getShardAddresses(service, *port.Number),
+			}, v1.EnvVar{
+				Name:  "SERVER_ADDRESS_" + key,
+				Value: fmt.Sprintf("0.0.0.0:%d", sharderListenPort(service, ix)),
+			})
+		}
+		env = append(env, v1.EnvVar{
+			Name:  "SHARD_PORTS",
+			Value: strings.Join(keys, ","),
+		})
+	}
+	spec := service.ShardSpec
+	if hashing := sharderHashing(spec); len(hashing) > 0 {
+		env = append(env, v1.EnvVar{
+			Name:  "SHARD_HASH_ALGORITHM",
+			Value: hashing,
+		})
+	}
+	source := shardKeySource(spec)
+	if len(source) == 0 {
+		return env
+	}
+	env = append(env, v1.EnvVar{
+		Name:  "SHARD_KEY_SOURCE",
+		Value: source,
+	})
+	switch source {
+	case shardKeyURL:
+		env = append(env, v1.EnvVar{
+			Name:  "SHARD_URL_PATTERN",
+			Value: spec.URLPattern,
+		})
+	case shardKeyField:
+		env = append(env, v1.EnvVar{
+			Name:  "SHARD_FIELD_PATH",
+			Value: spec.FieldPath,
+		})
+	case shardKeyHeader:
+		env = append(env, v1.EnvVar{
+			Name:  "SHARD_HEADER",
+			Value: spec.Header,
+		})
+	}
+	return env
+}
+
+func (k *kubernetesPlan) createStatefulService(service *models.ServiceSpecification, public bool, client *kubernetes.Clientset) error {
 	name := *service.Name
 
 	statefulSvc := &v1.Service{
@@ -356,7 +487,7 @@ func (k *kubernetesPlan) createStatefulService(service *models.ServiceSpecificat
 	k.output(statefulSvc, name+"-shards-service")
 	if !k.dryrun {
 		if _, err := client.CoreV1().Services("default").Create(statefulSvc); err != nil {
-			log.Fatalf(err.Error())
+			return err
 		}
 	}
 
@@ -368,50 +499,103 @@ func (k *kubernetesPlan) createStatefulService(service *models.ServiceSpecificat
 			Selector: map[string]string{
 				"app": makeSharderName(name),
 			},
-			Ports: getPorts(service),
+			Ports: getSharderPorts(service),
 		},
 	}
 	if public {
 		svc.Spec.Type = "LoadBalancer"
+		// keep the client address so the network policy can tell public
+		// traffic from traffic within the cluster
+		svc.Spec.ExternalTrafficPolicy = v1.ServiceExternalTrafficPolicyTypeLocal
 	}
 
 	k.output(svc, name+"-shard-router-service")
 	if k.dryrun {
-		return
+		return nil
 	}
 
-	if _, err := client.CoreV1().Services("default").Create(svc); err != nil {
-		log.Fatalf(err.Error())
-	}
+	_, err := client.CoreV1().Services("default").Create(svc)
+	return err
 }
 
 func (k *kubernetesCompiler) Compile(opts *CompilerOptions, obj *models.Service) (Plan, error) {
 	return &kubernetesPlan{service: obj, clientset: k.clientset, opts: opts}, nil
 }
 
-func (k *kubernetesPlan) createJob(obj *models.JobSpecification) error {
+// jobs returns the Kubernetes jobs for a job. batch/v1 has no indexed
+// completion mode, so indexed jobs get a job per completion instead.
+func (k *kubernetesPlan) jobs(obj *models.JobSpecification) ([]*batch.Job, error) {
 	name := *obj.Name
+	env, err := dependencyEnvVars(k.service, obj.Depends)
+	if err != nil {
+		return nil, err
+	}
+	if !obj.Indexed {
+		return []*batch.Job{k.job(obj, name, jobCompletions(obj), env)}, nil
+	}
+	jobs := []*batch.Job{}
+	for ix := int32(0); ix < jobCompletions(obj); ix++ {
+		indexEnv := append([]v1.EnvVar{}, env...)
+		for _, e := range jobIndexEnv(obj, ix) {
+			indexEnv = append(indexEnv, v1.EnvVar{
+				Name:  e.name,
+				Value: e.value,
+			})
+		}
+		jobs = append(jobs, k.job(obj, jobInstanceName(obj, ix), 1, indexEnv))
+	}
+	return jobs, nil
+}
+
+func (k *kubernetesPlan) job(obj *models.JobSpecification, name string, completions int32, env []v1.EnvVar) *batch.Job {
 	job := &batch.Job{
 		ObjectMeta: meta.ObjectMeta{
 			Name: name,
 		},
 		Spec: batch.JobSpec{
-			Completions: &obj.Replicas,
+			Completions:             &completions,
+			BackoffLimit:            obj.BackoffLimit,
+			TTLSecondsAfterFinished: obj.TTLSecondsAfterFinished,
 			Template: v1.PodTemplateSpec{
 				ObjectMeta: meta.ObjectMeta{
 					Labels: map[string]string{
-						"app": name,
+						"app": *obj.Name,
 					},
 				},
 				Spec: v1.PodSpec{
-					Containers:    containersForJob(obj),
-					RestartPolicy: "OnFailure",
+					Containers:    containersForJob(obj, env),
+					RestartPolicy: v1.RestartPolicy(jobRestartPolicy(obj)),
 				},
 			},
 		},
 	}
-	_, err := k.clientset.BatchV1().Jobs("default").Create(job)
-	return err
+	if obj.Parallelism > 0 {
+		job.Spec.Parallelism = &obj.Parallelism
+	}
+	if obj.ActiveDeadlineSeconds > 0 {
+		job.Spec.ActiveDeadlineSeconds = &obj.ActiveDeadlineSeconds
+	}
+	applyPlacement(&job.Spec.Template.Spec, obj.Placement, *obj.Name, false)
+	job.Spec.Template.Spec.ImagePullSecrets = imagePullSecrets(k.service)
+	applySecurity(&job.Spec.Template, obj.SecurityContext, obj.ServiceAccountName)
+	return job
+}
+
+func (k *kubernetesPlan) createJob(obj *models.JobSpecification) error {
+	jobs, err := k.jobs(obj)
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		k.output(job, job.Name+"-job")
+		if k.dryrun {
+			continue
+		}
+		if _, err := k.clientset.BatchV1().Jobs("default").Create(job); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 type kubernetesPlan struct {
@@ -430,6 +614,12 @@ func (k *kubernetesPlan) Execute(dryrun bool) error {
 	k.dryrun = dryrun
 	if k.delete {
 		for ix := range k.service.Services {
+			if err := k.deleteNetworkPolicies(k.service, k.service.Services[ix], k.clientset); err != nil {
+				return err
+			}
+			if err := k.deleteDisruptionBudget(k.service.Services[ix], k.clientset); err != nil {
+				return err
+			}
 			if err := k.deleteService(k.service.Services[ix], k.clientset); err != nil {
 				return err
 			}
@@ -439,24 +629,59 @@ func (k *kubernetesPlan) Execute(dryrun bool) error {
 				return err
 			}
 		}
-		return nil
+		if hasIngress(k.service) {
+			if err := k.deleteIngress(k.service, k.clientset); err != nil {
+				return err
+			}
+		}
+		return k.deleteRegistrySecrets(k.service, k.clientset)
 	}
 	service := k.service
-	for ix := range service.Services {
-		if service.Services[ix].Replicas > 0 && service.Services[ix].ShardSpec != nil {
-			return fmt.Errorf("%v: Replicas and shards are mutually exclusive", service.Services[ix].Name)
+	if err := Validate(service); err != nil {
+		return err
+	}
+	if !k.dryrun && hasSeccomp(service) {
+		version, err := k.clientset.Discovery().ServerVersion()
+		if err != nil {
+			return err
+		}
+		if err := validateSeccompSupport(version); err != nil {
+			return err
 		}
-		public := *service.Serve.Name == *service.Services[ix].Name && service.Serve.Public
+	}
+	if err := k.createRegistrySecrets(service, k.clientset); err != nil {
+		return err
+	}
+	for ix := range service.Services {
+		public := isPublic(service, *service.Services[ix].Name)
 		if service.Services[ix].Replicas > 0 {
-			k.deploy(service.Services[ix], k.clientset)
-			public := *service.Serve.Name == *service.Services[ix].Name && service.Serve.Public
+			if err := k.deploy(service.Services[ix], k.clientset); err != nil {
+				return err
+			}
 			if len(service.Services[ix].Ports) > 0 {
-				k.createLoadBalancedService(service.Services[ix], public, k.clientset)
+				if err := k.createLoadBalancedService(service.Services[ix], public, k.clientset); err != nil {
+					return err
+				}
 			}
 		}
 		if service.Services[ix].ShardSpec != nil && service.Services[ix].ShardSpec.Shards > 0 {
-			k.deployStateful(service.Services[ix], k.clientset)
-			k.createStatefulService(service.Services[ix], public, k.clientset)
+			if err := k.deployStateful(service.Services[ix], k.clientset); err != nil {
+				return err
+			}
+			if err := k.createStatefulService(service.Services[ix], public, k.clientset); err != nil {
+				return err
+			}
+		}
+		if err := k.createNetworkPolicies(service, service.Services[ix], k.clientset); err != nil {
+			return err
+		}
+		if err := k.createDisruptionBudget(service.Services[ix], k.clientset); err != nil {
+			return err
+		}
+	}
+	if hasIngress(service) {
+		if err := k.createIngress(service, k.clientset); err != nil {
+			return err
 		}
 	}
 	for ix := range service.Jobs {
@@ -472,6 +697,10 @@ func (k *kubernetesCompiler) Delete(opts *CompilerOptions, obj *models.Service)
 }
 
 func (k *kubernetesPlan) output(obj interface{}, name string) {
+	if recorder, ok := k.opts.writer().(StepRecorder); ok && (k.opts == nil || len(k.opts.WorkingDirectory) == 0) {
+		recorder.Object(name, obj)
+		return
+	}
 	data, err := json.MarshalIndent(obj, "", "  ")
 	if err != nil {
 		log.Fatalf(err.Error())
@@ -486,9 +715,9 @@ func (k *kubernetesPlan) output(obj interface{}, name string) {
 		stream = iofile
 		defer iofile.Close()
 	} else {
-		stream = os.Stdout
+		stream = k.opts.writer()
 	}
-	stream.Write(data)
+	stream.Write(append(data, '\n'))
 }
 
 func (k *kubernetesCompiler) Logs(svc *models.Service, stdout, stderr io.Writer) error {
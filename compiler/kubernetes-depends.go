@@ -0,0 +1,44 @@
+package compiler
+
+import (
+	"fmt"
+
+	"github.com/metaparticle-io/metaparticle-ast/models"
+	"k8s.io/api/core/v1"
+)
+
+// kubernetesHost returns the DNS name of a sub-service in its namespace,
+// sharded services are reached through their sharder. The resolver of the
+// pod completes it with the domain of the cluster, which isn't always
+// cluster.local.
+func kubernetesHost(service *models.ServiceSpecification) (string, error) {
+	return fmt.Sprintf("%s.%s", backendServiceName(service), v1.NamespaceDefault), nil
+}
+
+// dependencyEnvVars returns the environment variables pointing at the
+// dependencies of a service or job. Sharded dependencies also get
+// <NAME>_SHARD_ADDRESSES, so that clients can address the shards directly.
+func dependencyEnvVars(svc *models.Service, depends string) ([]v1.EnvVar, error) {
+	env, err := dependencyEnv(svc, depends, kubernetesHost)
+	if err != nil {
+		return nil, err
+	}
+	result := []v1.EnvVar{}
+	for _, e := range env {
+		result = append(result, v1.EnvVar{
+			Name:  e.name,
+			Value: e.value,
+		})
+	}
+	for _, dep := range parseDepends(depends) {
+		target := findService(svc, dep)
+		if target.ShardSpec == nil {
+			continue
+		}
+		result = append(result, v1.EnvVar{
+			Name:  envPrefix(dep) + "_SHARD_ADDRESSES",
+			Value: getShardAddresses(target, *shardedPorts(target)[0].Number),
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,64 @@
+package compiler
+
+import (
+	"github.com/metaparticle-io/metaparticle-ast/models"
+	policy "k8s.io/api/policy/v1beta1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+)
+
+// podDisruptionBudget returns the budget for the pods of a service, using the
+// same app selector as its Deployment or StatefulSet.
+func podDisruptionBudget(service *models.ServiceSpecification) *policy.PodDisruptionBudget {
+	budget := disruptionBudget(service)
+	if budget == nil {
+		return nil
+	}
+	name := *service.Name
+	pdb := &policy.PodDisruptionBudget{
+		ObjectMeta: meta.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				"app": name,
+			},
+		},
+		Spec: policy.PodDisruptionBudgetSpec{
+			Selector: appSelector(name),
+		},
+	}
+	if len(budget.MinAvailable) > 0 {
+		value := intstr.Parse(budget.MinAvailable)
+		pdb.Spec.MinAvailable = &value
+	}
+	if len(budget.MaxUnavailable) > 0 {
+		value := intstr.Parse(budget.MaxUnavailable)
+		pdb.Spec.MaxUnavailable = &value
+	}
+	return pdb
+}
+
+func (k *kubernetesPlan) createDisruptionBudget(service *models.ServiceSpecification, client *kubernetes.Clientset) error {
+	pdb := podDisruptionBudget(service)
+	if pdb == nil {
+		return nil
+	}
+	k.output(pdb, pdb.Name+"-disruption-budget")
+	if k.dryrun {
+		return nil
+	}
+	_, err := client.PolicyV1beta1().PodDisruptionBudgets("default").Create(pdb)
+	return err
+}
+
+func (k *kubernetesPlan) deleteDisruptionBudget(service *models.ServiceSpecification, client *kubernetes.Clientset) error {
+	pdb := podDisruptionBudget(service)
+	if pdb == nil {
+		return nil
+	}
+	if k.dryrun {
+		dryRunNote(k.opts.writer(), "Would have deleted disruption budget %s", pdb.Name)
+		return nil
+	}
+	return client.PolicyV1beta1().PodDisruptionBudgets("default").Delete(pdb.Name, deleteOptions)
+}
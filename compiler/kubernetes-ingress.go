@@ -0,0 +1,115 @@
+package compiler
+
+import (
+	"github.com/metaparticle-io/metaparticle-ast/models"
+	"k8s.io/api/extensions/v1beta1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+)
+
+const ingressClassAnnotation = "kubernetes.io/ingress.class"
+
+// backendServiceName returns the name of the Kubernetes service that receives
+// traffic for a sub-service, sharded services are reached through their sharder.
+func backendServiceName(service *models.ServiceSpecification) string {
+	if service.ShardSpec != nil {
+		return makeSharderName(*service.Name)
+	}
+	return *service.Name
+}
+
+// createIngress creates a single Ingress for all of the routed serve entries
+// of a service, so that the whole application shares one load balancer.
+func (k *kubernetesPlan) createIngress(svc *models.Service, client *kubernetes.Clientset) error {
+	name := *svc.Name
+
+	hosts := []string{}
+	paths := map[string][]v1beta1.HTTPIngressPath{}
+	tls := []v1beta1.IngressTLS{}
+	ingressClass := ""
+	for _, serve := range serveSpecifications(svc) {
+		if !isIngress(serve) {
+			continue
+		}
+		if len(serve.IngressClass) > 0 {
+			ingressClass = serve.IngressClass
+		}
+		if len(serve.TLSSecret) > 0 {
+			tls = append(tls, v1beta1.IngressTLS{
+				Hosts:      serve.Hostnames,
+				SecretName: serve.TLSSecret,
+			})
+		}
+		serveHosts := serve.Hostnames
+		if len(serveHosts) == 0 {
+			serveHosts = []string{""}
+		}
+		for _, path := range servePaths(serve) {
+			port, err := servePort(svc, path)
+			if err != nil {
+				return err
+			}
+			ingressPath := v1beta1.HTTPIngressPath{
+				Path: *path.Path,
+				Backend: v1beta1.IngressBackend{
+					ServiceName: backendServiceName(findService(svc, *path.Service)),
+					ServicePort: intstr.FromInt(int(port)),
+				},
+			}
+			for _, host := range serveHosts {
+				if _, found := paths[host]; !found {
+					hosts = append(hosts, host)
+				}
+				paths[host] = append(paths[host], ingressPath)
+			}
+		}
+	}
+
+	rules := []v1beta1.IngressRule{}
+	for _, host := range hosts {
+		rules = append(rules, v1beta1.IngressRule{
+			Host: host,
+			IngressRuleValue: v1beta1.IngressRuleValue{
+				HTTP: &v1beta1.HTTPIngressRuleValue{
+					Paths: paths[host],
+				},
+			},
+		})
+	}
+
+	ingress := &v1beta1.Ingress{
+		ObjectMeta: meta.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				"app": name,
+			},
+		},
+		Spec: v1beta1.IngressSpec{
+			TLS:   tls,
+			Rules: rules,
+		},
+	}
+	if len(ingressClass) > 0 {
+		ingress.ObjectMeta.Annotations = map[string]string{
+			ingressClassAnnotation: ingressClass,
+		}
+	}
+
+	k.output(ingress, name+"-ingress")
+	if k.dryrun {
+		return nil
+	}
+
+	_, err := client.ExtensionsV1beta1().Ingresses("default").Create(ingress)
+	return err
+}
+
+func (k *kubernetesPlan) deleteIngress(svc *models.Service, client *kubernetes.Clientset) error {
+	name := *svc.Name
+	if k.dryrun {
+		dryRunNote(k.opts.writer(), "Would have deleted ingress %s", name)
+		return nil
+	}
+	return client.ExtensionsV1beta1().Ingresses("default").Delete(name, deleteOptions)
+}
@@ -0,0 +1,107 @@
+package compiler
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/api/extensions/v1beta1"
+)
+
+const routedSpec = `{
+	"name": "shop",
+	"services": [
+		{"name": "web", "ports": [{"number": 80}]},
+		{"name": "api", "ports": [{"number": 8080}, {"number": 9090}]},
+		{"name": "db", "ports": [{"number": 5432}]}
+	],
+	"serve": {"name": "web", "hostnames": ["example.com"], "tlsSecret": "example-tls", "ingressClass": "nginx"},
+	"serves": [
+		{"name": "api", "hostnames": ["example.com"], "paths": [{"path": "/api", "service": "api", "port": 9090}]}
+	]
+}`
+
+func TestCreateIngress(t *testing.T) {
+	svc := parseService(t, routedSpec)
+	plan, out := dryRunPlan(svc)
+	if err := plan.createIngress(svc, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ingress, ok := out.objects["shop-ingress"].(*v1beta1.Ingress)
+	if !ok {
+		t.Fatalf("expected an ingress, got %v", out.objects)
+	}
+	if ingress.Annotations[ingressClassAnnotation] != "nginx" {
+		t.Errorf("unexpected annotations %v", ingress.Annotations)
+	}
+	if len(ingress.Spec.TLS) != 1 || ingress.Spec.TLS[0].SecretName != "example-tls" {
+		t.Errorf("unexpected TLS %#v", ingress.Spec.TLS)
+	}
+	if len(ingress.Spec.Rules) != 1 || ingress.Spec.Rules[0].Host != "example.com" {
+		t.Fatalf("expected one rule for example.com, got %#v", ingress.Spec.Rules)
+	}
+	paths := ingress.Spec.Rules[0].HTTP.Paths
+	expected := map[string]string{"/": "web:80", "/api": "api:9090"}
+	if len(paths) != len(expected) {
+		t.Fatalf("unexpected paths %#v", paths)
+	}
+	for _, path := range paths {
+		backend := path.Backend.ServiceName + ":" + path.Backend.ServicePort.String()
+		if expected[path.Path] != backend {
+			t.Errorf("path %s goes to %s, expected %s", path.Path, backend, expected[path.Path])
+		}
+	}
+}
+
+func TestServeRouting(t *testing.T) {
+	svc := parseService(t, routedSpec)
+	tests := []struct {
+		name   string
+		public bool
+		served bool
+	}{
+		{name: "web", public: false, served: true},
+		{name: "api", public: false, served: true},
+		{name: "db", public: false, served: false},
+	}
+	for _, test := range tests {
+		if public := isPublic(svc, test.name); public != test.public {
+			t.Errorf("isPublic(%s) = %v, expected %v", test.name, public, test.public)
+		}
+		if served := isServed(svc, test.name); served != test.served {
+			t.Errorf("isServed(%s) = %v, expected %v", test.name, served, test.served)
+		}
+	}
+	if !hasIngress(svc) {
+		t.Errorf("expected an ingress")
+	}
+}
+
+func TestValidateServe(t *testing.T) {
+	tests := []struct {
+		name  string
+		serve string
+		err   string
+	}{
+		{name: "public", serve: `{"name": "web", "public": true}`},
+		{name: "routed", serve: `{"name": "web", "hostnames": ["example.com"], "paths": [{"path": "/", "service": "web"}]}`},
+		{name: "unknown service", serve: `{"name": "missing", "public": true}`, err: "unknown service missing"},
+		{name: "tls without hostnames", serve: `{"name": "web", "public": true, "tlsSecret": "tls"}`, err: "require hostnames or paths"},
+		{name: "relative path", serve: `{"name": "web", "paths": [{"path": "api", "service": "web"}]}`, err: "must start with '/'"},
+		{name: "unknown port", serve: `{"name": "web", "paths": [{"path": "/", "service": "web", "port": 81}]}`, err: "has no port 81"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := parseService(t, `{"name": "app", "services": [{"name": "web", "ports": [{"number": 80}]}], "serve": `+test.serve+`}`)
+			err := validateServe(svc)
+			if len(test.err) == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("expected an error containing %q, got %v", test.err, err)
+			}
+		})
+	}
+}
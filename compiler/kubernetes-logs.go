@@ -0,0 +1,95 @@
+package compiler
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/metaparticle-io/metaparticle-ast/ktail"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+func (k *kubernetesCompiler) StreamLogs(svc *models.Service, opts *LogOptions, stop <-chan struct{}, handle func(*models.LogEvent)) error {
+	// the app label of every pod names its sub-service, sharder or job
+	apps := map[string]string{}
+	for _, service := range svc.Services {
+		if len(opts.Container) > 0 && opts.Container != *service.Name {
+			continue
+		}
+		apps[*service.Name] = *service.Name
+		if service.ShardSpec != nil {
+			apps[makeSharderName(*service.Name)] = *service.Name
+		}
+	}
+	for _, job := range svc.Jobs {
+		if len(opts.Container) == 0 || opts.Container == *job.Name {
+			apps[*job.Name] = *job.Name
+		}
+	}
+	if len(apps) == 0 {
+		return nil
+	}
+	names := []string{}
+	for app := range apps {
+		names = append(names, app)
+	}
+	sort.Strings(names)
+	requirement, err := labels.NewRequirement("app", selection.In, names)
+	if err != nil {
+		return err
+	}
+
+	var lock sync.Mutex
+	var tailErr error
+	stopped := false
+	controller := ktail.NewController(k.clientset, v1.NamespaceDefault, labels.NewSelector().Add(*requirement),
+		ktail.Callbacks{
+			OnEvent: func(event ktail.LogEvent) {
+				message := event.Message
+				logEvent := &models.LogEvent{
+					Service:   apps[event.Pod.Labels["app"]],
+					Pod:       event.Pod.Name,
+					Container: event.Container.Name,
+					Message:   &message,
+				}
+				if event.Timestamp != nil {
+					logEvent.Timestamp = strfmt.DateTime(*event.Timestamp)
+				}
+				lock.Lock()
+				defer lock.Unlock()
+				if !stopped {
+					handle(logEvent)
+				}
+			},
+			OnEnter: func(pod *v1.Pod, container *v1.Container) bool {
+				return true
+			},
+			OnExit: func(pod *v1.Pod, container *v1.Container) {},
+			OnError: func(pod *v1.Pod, container *v1.Container, err error) {
+				lock.Lock()
+				defer lock.Unlock()
+				if tailErr == nil {
+					tailErr = fmt.Errorf("error while tailing %s:%s: %v", pod.Name, container.Name, err)
+				}
+			},
+		})
+	controller.SetOptions(ktail.TailOptions{
+		Since:         opts.Since,
+		FromBeginning: true,
+		NoFollow:      !opts.Follow,
+	})
+	err = controller.RunUntil(stop)
+
+	lock.Lock()
+	defer lock.Unlock()
+	// tailers can still be reading a line after they are stopped
+	stopped = true
+	if err != nil {
+		return err
+	}
+	return tailErr
+}
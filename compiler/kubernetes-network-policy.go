@@ -0,0 +1,124 @@
+package compiler
+
+import (
+	"github.com/metaparticle-io/metaparticle-ast/models"
+	networking "k8s.io/api/networking/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ingressControllerLabel marks the namespaces of ingress controllers, whose
+// pods may reach the services routed through an ingress.
+const ingressControllerLabel = "metaparticle.io/ingress-controller"
+
+// privateRanges hold the pod and node addresses of a cluster, traffic from
+// a public load balancer is admitted from everywhere else.
+var privateRanges = []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"}
+
+// servedPeers returns the sources outside the application that may reach a
+// sub-service: the ingress controllers if it is routed through an ingress,
+// and public addresses if it has a load balancer of its own.
+func servedPeers(svc *models.Service, name string) []networking.NetworkPolicyPeer {
+	peers := []networking.NetworkPolicyPeer{}
+	if isRouted(svc, name) {
+		peers = append(peers, networking.NetworkPolicyPeer{
+			NamespaceSelector: &meta.LabelSelector{
+				MatchLabels: map[string]string{
+					ingressControllerLabel: "true",
+				},
+			},
+		})
+	}
+	if isPublic(svc, name) {
+		peers = append(peers, networking.NetworkPolicyPeer{
+			IPBlock: &networking.IPBlock{
+				CIDR:   "0.0.0.0/0",
+				Except: privateRanges,
+			},
+		})
+	}
+	return peers
+}
+
+// networkPolicy builds a policy that only admits traffic to the pods with
+// app=name from the given apps and served peers.
+// Without any sources the pods are isolated completely.
+func networkPolicy(name string, from []string, served []networking.NetworkPolicyPeer) *networking.NetworkPolicy {
+	rules := []networking.NetworkPolicyIngressRule{}
+	peers := []networking.NetworkPolicyPeer{}
+	for _, app := range from {
+		peers = append(peers, networking.NetworkPolicyPeer{
+			PodSelector: appSelector(app),
+		})
+	}
+	peers = append(peers, served...)
+	if len(peers) > 0 {
+		rules = append(rules, networking.NetworkPolicyIngressRule{
+			From: peers,
+		})
+	}
+	return &networking.NetworkPolicy{
+		ObjectMeta: meta.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				"app": name,
+			},
+		},
+		Spec: networking.NetworkPolicySpec{
+			PodSelector: *appSelector(name),
+			Ingress:     rules,
+			PolicyTypes: []networking.PolicyType{
+				networking.PolicyTypeIngress,
+			},
+		},
+	}
+}
+
+// networkPolicies returns the policies for a sub-service. Its pods accept
+// traffic from the services that depend on it, and from the ingress
+// controllers or public addresses if it is served. Sharded services are reached through their sharder, so the shards
+// also accept traffic from the sharder.
+func networkPolicies(svc *models.Service, service *models.ServiceSpecification) []*networking.NetworkPolicy {
+	if service.DisableNetworkPolicy {
+		return nil
+	}
+	name := *service.Name
+	from := dependents(svc, name)
+	served := servedPeers(svc, name)
+	if service.ShardSpec == nil {
+		return []*networking.NetworkPolicy{
+			networkPolicy(name, from, served),
+		}
+	}
+	sharder := makeSharderName(name)
+	return []*networking.NetworkPolicy{
+		networkPolicy(name, append([]string{sharder}, from...), nil),
+		networkPolicy(sharder, from, served),
+	}
+}
+
+func (k *kubernetesPlan) createNetworkPolicies(svc *models.Service, service *models.ServiceSpecification, client *kubernetes.Clientset) error {
+	for _, policy := range networkPolicies(svc, service) {
+		k.output(policy, policy.Name+"-network-policy")
+		if k.dryrun {
+			continue
+		}
+		if _, err := client.NetworkingV1().NetworkPolicies("default").Create(policy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k *kubernetesPlan) deleteNetworkPolicies(svc *models.Service, service *models.ServiceSpecification, client *kubernetes.Clientset) error {
+	for _, policy := range networkPolicies(svc, service) {
+		if k.dryrun {
+			dryRunNote(k.opts.writer(), "Would have deleted network policy %s", policy.Name)
+			continue
+		}
+		if err := client.NetworkingV1().NetworkPolicies("default").Delete(policy.Name, deleteOptions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
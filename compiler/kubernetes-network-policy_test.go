@@ -0,0 +1,123 @@
+package compiler
+
+import (
+	"strings"
+	"testing"
+
+	networking "k8s.io/api/networking/v1"
+)
+
+// describePeers returns a short description of each peer of a policy.
+func describePeers(policy *networking.NetworkPolicy) []string {
+	result := []string{}
+	for _, rule := range policy.Spec.Ingress {
+		if len(rule.From) == 0 {
+			result = append(result, "everything")
+		}
+		for _, peer := range rule.From {
+			switch {
+			case peer.PodSelector != nil:
+				result = append(result, "app="+peer.PodSelector.MatchLabels["app"])
+			case peer.NamespaceSelector != nil:
+				result = append(result, "namespace "+ingressControllerLabel+"="+peer.NamespaceSelector.MatchLabels[ingressControllerLabel])
+			case peer.IPBlock != nil:
+				result = append(result, peer.IPBlock.CIDR+" except "+strings.Join(peer.IPBlock.Except, ","))
+			}
+		}
+	}
+	return result
+}
+
+func TestNetworkPolicies(t *testing.T) {
+	private := "0.0.0.0/0 except 10.0.0.0/8,172.16.0.0/12,192.168.0.0/16"
+	ingress := "namespace " + ingressControllerLabel + "=true"
+	tests := []struct {
+		name     string
+		spec     string
+		service  string
+		expected map[string][]string
+	}{
+		{
+			name: "isolated",
+			spec: `{"name": "app", "services": [{"name": "db", "ports": [{"number": 5432}]}]}`,
+			expected: map[string][]string{
+				"db": {},
+			},
+		},
+		{
+			name: "dependents",
+			spec: `{"name": "app", "services": [{"name": "db"}, {"name": "web", "depends": "db"}], "jobs": [{"name": "migrate", "depends": "db"}]}`,
+			expected: map[string][]string{
+				"db":  {"app=web", "app=migrate"},
+				"web": {},
+			},
+		},
+		{
+			name: "public",
+			spec: `{"name": "app", "services": [{"name": "web", "ports": [{"number": 80}]}], "serve": {"name": "web", "public": true}}`,
+			expected: map[string][]string{
+				"web": {private},
+			},
+		},
+		{
+			name: "ingress",
+			spec: `{"name": "app", "services": [{"name": "web", "ports": [{"number": 80}]}, {"name": "api", "ports": [{"number": 80}]}], "serve": {"name": "web", "paths": [{"path": "/api", "service": "api"}]}}`,
+			expected: map[string][]string{
+				"web": {},
+				"api": {ingress},
+			},
+		},
+		{
+			name: "public and ingress",
+			spec: `{"name": "app", "services": [{"name": "web", "ports": [{"number": 80}]}], "serves": [{"name": "web", "public": true}, {"name": "web", "hostnames": ["example.com"]}]}`,
+			expected: map[string][]string{
+				"web": {ingress, private},
+			},
+		},
+		{
+			name: "sharded",
+			spec: `{"name": "app", "services": [{"name": "users", "ports": [{"number": 80}], "shardSpec": {"shards": 2}}, {"name": "web", "depends": "users"}], "serve": {"name": "users", "public": true}}`,
+			expected: map[string][]string{
+				"users":         {"app=users-sharder", "app=web"},
+				"users-sharder": {"app=web", private},
+				"web":           {},
+			},
+		},
+		{
+			name:     "disabled",
+			spec:     `{"name": "app", "services": [{"name": "web", "disableNetworkPolicy": true}], "serve": {"name": "web", "public": true}}`,
+			expected: map[string][]string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := parseService(t, test.spec)
+			policies := []*networking.NetworkPolicy{}
+			for _, service := range svc.Services {
+				policies = append(policies, networkPolicies(svc, service)...)
+			}
+			if len(policies) != len(test.expected) {
+				t.Fatalf("expected %d policies, got %d", len(test.expected), len(policies))
+			}
+			for _, policy := range policies {
+				expected, found := test.expected[policy.Name]
+				if !found {
+					t.Errorf("unexpected policy %s", policy.Name)
+					continue
+				}
+				if policy.Spec.PodSelector.MatchLabels["app"] != policy.Name {
+					t.Errorf("%s: unexpected pod selector %v", policy.Name, policy.Spec.PodSelector)
+				}
+				peers := describePeers(policy)
+				if strings.Join(peers, ";") != strings.Join(expected, ";") {
+					t.Errorf("%s: peers %v, expected %v", policy.Name, peers, expected)
+				}
+				for _, rule := range policy.Spec.Ingress {
+					if len(rule.From) == 0 {
+						t.Errorf("%s: admits traffic from everywhere", policy.Name)
+					}
+				}
+			}
+		})
+	}
+}
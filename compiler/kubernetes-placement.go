@@ -0,0 +1,203 @@
+package compiler
+
+import (
+	"fmt"
+
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+	"k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	hostnameTopologyKey = "kubernetes.io/hostname"
+	zoneTopologyKey     = "failure-domain.beta.kubernetes.io/zone"
+
+	defaultAffinityWeight = int32(100)
+)
+
+// applyPlacement translates a placement specification into the scheduling
+// constraints of a pod spec. name is the value of the 'app' label carried by
+// the pods, it is used to spread them across zones.
+func applyPlacement(spec *v1.PodSpec, placement *models.PlacementSpecification, name string, spreadAcrossZones bool) {
+	if placement != nil {
+		spreadAcrossZones = spreadAcrossZones || placement.SpreadAcrossZones
+	}
+	if placement == nil && !spreadAcrossZones {
+		return
+	}
+	affinity := &v1.Affinity{}
+	if placement != nil {
+		spec.NodeSelector = placement.NodeSelector
+		spec.Tolerations = tolerations(placement)
+		affinity.NodeAffinity = nodeAffinity(placement)
+		affinity.PodAffinity = podAffinity(placement.PodAffinity)
+		affinity.PodAntiAffinity = podAntiAffinity(placement.PodAntiAffinity)
+	}
+	if spreadAcrossZones {
+		if affinity.PodAntiAffinity == nil {
+			affinity.PodAntiAffinity = &v1.PodAntiAffinity{}
+		}
+		affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(
+			affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+			v1.WeightedPodAffinityTerm{
+				Weight: defaultAffinityWeight,
+				PodAffinityTerm: v1.PodAffinityTerm{
+					LabelSelector: appSelector(name),
+					TopologyKey:   zoneTopologyKey,
+				},
+			})
+	}
+	if affinity.NodeAffinity != nil || affinity.PodAffinity != nil || affinity.PodAntiAffinity != nil {
+		spec.Affinity = affinity
+	}
+}
+
+func appSelector(name string) *meta.LabelSelector {
+	return &meta.LabelSelector{
+		MatchLabels: map[string]string{
+			"app": name,
+		},
+	}
+}
+
+func tolerations(placement *models.PlacementSpecification) []v1.Toleration {
+	result := []v1.Toleration{}
+	for _, t := range placement.Tolerations {
+		toleration := v1.Toleration{
+			Key:      t.Key,
+			Operator: v1.TolerationOperator(t.Operator),
+			Value:    t.Value,
+			Effect:   v1.TaintEffect(t.Effect),
+		}
+		if t.TolerationSeconds > 0 {
+			seconds := t.TolerationSeconds
+			toleration.TolerationSeconds = &seconds
+		}
+		result = append(result, toleration)
+	}
+	return result
+}
+
+func nodeAffinity(placement *models.PlacementSpecification) *v1.NodeAffinity {
+	if len(placement.NodeAffinity) == 0 {
+		return nil
+	}
+	requirements := []v1.NodeSelectorRequirement{}
+	for _, r := range placement.NodeAffinity {
+		requirements = append(requirements, v1.NodeSelectorRequirement{
+			Key:      *r.Key,
+			Operator: v1.NodeSelectorOperator(*r.Operator),
+			Values:   r.Values,
+		})
+	}
+	return &v1.NodeAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+			NodeSelectorTerms: []v1.NodeSelectorTerm{
+				v1.NodeSelectorTerm{
+					MatchExpressions: requirements,
+				},
+			},
+		},
+	}
+}
+
+// podAffinityTerms splits the terms into hard requirements and weighted preferences.
+func podAffinityTerms(terms []*models.PodAffinityTerm) ([]v1.PodAffinityTerm, []v1.WeightedPodAffinityTerm) {
+	required := []v1.PodAffinityTerm{}
+	preferred := []v1.WeightedPodAffinityTerm{}
+	for _, t := range terms {
+		term := v1.PodAffinityTerm{
+			LabelSelector: appSelector(*t.Service),
+			TopologyKey:   t.TopologyKey,
+		}
+		if len(term.TopologyKey) == 0 {
+			term.TopologyKey = hostnameTopologyKey
+		}
+		if !t.Preferred {
+			required = append(required, term)
+			continue
+		}
+		weight := t.Weight
+		if weight == 0 {
+			weight = defaultAffinityWeight
+		}
+		preferred = append(preferred, v1.WeightedPodAffinityTerm{
+			Weight:          weight,
+			PodAffinityTerm: term,
+		})
+	}
+	return required, preferred
+}
+
+func podAffinity(terms []*models.PodAffinityTerm) *v1.PodAffinity {
+	if len(terms) == 0 {
+		return nil
+	}
+	required, preferred := podAffinityTerms(terms)
+	return &v1.PodAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution:  required,
+		PreferredDuringSchedulingIgnoredDuringExecution: preferred,
+	}
+}
+
+func podAntiAffinity(terms []*models.PodAffinityTerm) *v1.PodAntiAffinity {
+	if len(terms) == 0 {
+		return nil
+	}
+	required, preferred := podAffinityTerms(terms)
+	return &v1.PodAntiAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution:  required,
+		PreferredDuringSchedulingIgnoredDuringExecution: preferred,
+	}
+}
+
+// validatePlacement checks the parts of a placement that the schema can't express.
+func validatePlacement(svc *models.Service, owner string, placement *models.PlacementSpecification) error {
+	if placement == nil {
+		return nil
+	}
+	for _, t := range placement.Tolerations {
+		if err := t.Validate(strfmt.Default); err != nil {
+			return fmt.Errorf("%s: %v", owner, err)
+		}
+		if t.Operator == models.TolerationOperatorExists && len(t.Value) > 0 {
+			return fmt.Errorf("%s: toleration for %q can't have a value with operator Exists", owner, t.Key)
+		}
+	}
+	for _, r := range placement.NodeAffinity {
+		if err := r.Validate(strfmt.Default); err != nil {
+			return fmt.Errorf("%s: %v", owner, err)
+		}
+		switch *r.Operator {
+		case models.LabelRequirementOperatorIn, models.LabelRequirementOperatorNotIn:
+			if len(r.Values) == 0 {
+				return fmt.Errorf("%s: node affinity for %q requires values with operator %s", owner, *r.Key, *r.Operator)
+			}
+		default:
+			if len(r.Values) > 0 {
+				return fmt.Errorf("%s: node affinity for %q can't have values with operator %s", owner, *r.Key, *r.Operator)
+			}
+		}
+	}
+	terms := append([]*models.PodAffinityTerm{}, placement.PodAffinity...)
+	terms = append(terms, placement.PodAntiAffinity...)
+	for _, t := range terms {
+		if err := t.Validate(strfmt.Default); err != nil {
+			return fmt.Errorf("%s: %v", owner, err)
+		}
+		if findService(svc, *t.Service) == nil {
+			return fmt.Errorf("%s: pod affinity refers to unknown service %s", owner, *t.Service)
+		}
+	}
+	return nil
+}
+
+func findService(svc *models.Service, name string) *models.ServiceSpecification {
+	for _, s := range svc.Services {
+		if *s.Name == name {
+			return s
+		}
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package compiler
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/api/core/v1"
+)
+
+func TestApplyPlacement(t *testing.T) {
+	tests := []struct {
+		name      string
+		placement string
+		spread    bool
+		check     func(t *testing.T, spec *v1.PodSpec)
+	}{
+		{
+			name: "none",
+			check: func(t *testing.T, spec *v1.PodSpec) {
+				if spec.Affinity != nil || spec.NodeSelector != nil || spec.Tolerations != nil {
+					t.Errorf("expected no constraints, got %#v", spec)
+				}
+			},
+		},
+		{
+			name:      "node selector and tolerations",
+			placement: `{"nodeSelector": {"pool": "standard"}, "tolerations": [{"key": "dedicated", "operator": "Equal", "value": "batch", "effect": "NoSchedule", "tolerationSeconds": 30}]}`,
+			check: func(t *testing.T, spec *v1.PodSpec) {
+				if !reflect.DeepEqual(spec.NodeSelector, map[string]string{"pool": "standard"}) {
+					t.Errorf("unexpected node selector %v", spec.NodeSelector)
+				}
+				if len(spec.Tolerations) != 1 {
+					t.Fatalf("expected a toleration, got %v", spec.Tolerations)
+				}
+				toleration := spec.Tolerations[0]
+				if toleration.Key != "dedicated" || toleration.Operator != v1.TolerationOpEqual || toleration.Effect != v1.TaintEffectNoSchedule {
+					t.Errorf("unexpected toleration %#v", toleration)
+				}
+				if toleration.TolerationSeconds == nil || *toleration.TolerationSeconds != 30 {
+					t.Errorf("unexpected toleration seconds %v", toleration.TolerationSeconds)
+				}
+				if spec.Affinity != nil {
+					t.Errorf("expected no affinity, got %#v", spec.Affinity)
+				}
+			},
+		},
+		{
+			name:      "node affinity",
+			placement: `{"nodeAffinity": [{"key": "accelerator", "operator": "DoesNotExist"}]}`,
+			check: func(t *testing.T, spec *v1.PodSpec) {
+				terms := spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+				if len(terms) != 1 || len(terms[0].MatchExpressions) != 1 {
+					t.Fatalf("unexpected terms %#v", terms)
+				}
+				if expr := terms[0].MatchExpressions[0]; expr.Key != "accelerator" || expr.Operator != v1.NodeSelectorOpDoesNotExist {
+					t.Errorf("unexpected requirement %#v", expr)
+				}
+			},
+		},
+		{
+			name:      "pod affinity terms",
+			placement: `{"podAffinity": [{"service": "cache"}], "podAntiAffinity": [{"service": "server", "preferred": true}]}`,
+			check: func(t *testing.T, spec *v1.PodSpec) {
+				required := spec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+				if len(required) != 1 || required[0].TopologyKey != hostnameTopologyKey || required[0].LabelSelector.MatchLabels["app"] != "cache" {
+					t.Errorf("unexpected pod affinity %#v", required)
+				}
+				preferred := spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+				if len(preferred) != 1 || preferred[0].Weight != defaultAffinityWeight || preferred[0].PodAffinityTerm.LabelSelector.MatchLabels["app"] != "server" {
+					t.Errorf("unexpected pod anti-affinity %#v", preferred)
+				}
+			},
+		},
+		{
+			name:   "spread across zones",
+			spread: true,
+			check: func(t *testing.T, spec *v1.PodSpec) {
+				preferred := spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+				if len(preferred) != 1 || preferred[0].PodAffinityTerm.TopologyKey != zoneTopologyKey || preferred[0].PodAffinityTerm.LabelSelector.MatchLabels["app"] != "web" {
+					t.Errorf("unexpected zone spreading %#v", preferred)
+				}
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			spec := &v1.PodSpec{}
+			svc := parseService(t, `{"name": "app", "services": [{"name": "web", "placement": `+orNull(test.placement)+`}]}`)
+			applyPlacement(spec, svc.Services[0].Placement, "web", test.spread)
+			test.check(t, spec)
+		})
+	}
+}
+
+func TestValidatePlacement(t *testing.T) {
+	tests := []struct {
+		name      string
+		placement string
+		err       string
+	}{
+		{
+			name:      "valid",
+			placement: `{"tolerations": [{"key": "gpu", "operator": "Exists"}], "nodeAffinity": [{"key": "zone", "operator": "In", "values": ["a"]}], "podAffinity": [{"service": "web"}]}`,
+		},
+		{
+			name:      "value with Exists",
+			placement: `{"tolerations": [{"key": "gpu", "operator": "Exists", "value": "yes"}]}`,
+			err:       "can't have a value",
+		},
+		{
+			name:      "In without values",
+			placement: `{"nodeAffinity": [{"key": "zone", "operator": "In"}]}`,
+			err:       "requires values",
+		},
+		{
+			name:      "Exists with values",
+			placement: `{"nodeAffinity": [{"key": "zone", "operator": "Exists", "values": ["a"]}]}`,
+			err:       "can't have values",
+		},
+		{
+			name:      "unknown service",
+			placement: `{"podAntiAffinity": [{"service": "missing"}]}`,
+			err:       "unknown service missing",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := parseService(t, `{"name": "app", "services": [{"name": "web", "placement": `+test.placement+`}]}`)
+			err := validatePlacement(svc, "service web", svc.Services[0].Placement)
+			if len(test.err) == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("expected an error containing %q, got %v", test.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package compiler
+
+import (
+	"io/ioutil"
+
+	"github.com/metaparticle-io/metaparticle-ast/models"
+	"k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// imagePullSecrets returns references to the docker-registry secrets of a service.
+func imagePullSecrets(svc *models.Service) []v1.LocalObjectReference {
+	secrets := []v1.LocalObjectReference{}
+	for ix := range svc.Registries {
+		secrets = append(secrets, v1.LocalObjectReference{
+			Name: registrySecretName(svc, ix),
+		})
+	}
+	return secrets
+}
+
+// createRegistrySecrets creates the docker-registry secrets of the
+// registries that point at a local docker config. Secrets of the other
+// registries are expected to exist already.
+func (k *kubernetesPlan) createRegistrySecrets(svc *models.Service, client *kubernetes.Clientset) error {
+	for ix, registry := range svc.Registries {
+		if len(registry.DockerConfig) == 0 {
+			continue
+		}
+		name := registrySecretName(svc, ix)
+		secret := &v1.Secret{
+			ObjectMeta: meta.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					"app": *svc.Name,
+				},
+			},
+			Type: v1.SecretTypeDockerConfigJson,
+			// don't write the credentials out with the rest of the manifests
+			Data: map[string][]byte{
+				v1.DockerConfigJsonKey: []byte("<redacted>"),
+			},
+		}
+		k.output(secret, name+"-secret")
+		if k.dryrun {
+			// the docker config is only read when the secret is created, so
+			// plans don't depend on the files of the machine making them
+			continue
+		}
+
+		data, err := ioutil.ReadFile(registry.DockerConfig)
+		if err != nil {
+			return err
+		}
+		secret.Data = map[string][]byte{
+			v1.DockerConfigJsonKey: data,
+		}
+
+		if _, err := client.CoreV1().Secrets("default").Create(secret); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k *kubernetesPlan) deleteRegistrySecrets(svc *models.Service, client *kubernetes.Clientset) error {
+	for ix, registry := range svc.Registries {
+		if len(registry.DockerConfig) == 0 {
+			continue
+		}
+		name := registrySecretName(svc, ix)
+		if k.dryrun {
+			dryRunNote(k.opts.writer(), "Would have deleted secret %s", name)
+			continue
+		}
+		if err := client.CoreV1().Secrets("default").Delete(name, deleteOptions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package compiler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/metaparticle-io/metaparticle-ast/models"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+const (
+	seccompPodAnnotation = "seccomp.security.alpha.kubernetes.io/pod"
+	// seccompAnnotationLastMinor is the last Kubernetes 1.x release that
+	// copies the deprecated seccomp annotation into the seccompProfile field
+	// of the pod, later releases ignore the annotation.
+	seccompAnnotationLastMinor = 26
+)
+
+// applySecurity applies a security context to a pod template and all of its
+// containers. The seccomp profile is set with the pod annotation, as the
+// PodSpec of the client library has no field for it, so it only takes
+// effect on clusters that pass validateSeccompSupport.
+func applySecurity(template *v1.PodTemplateSpec, security *models.SecurityContext, serviceAccountName string) {
+	template.Spec.ServiceAccountName = serviceAccountName
+	if security == nil {
+		return
+	}
+
+	runAsNonRoot := security.RunAsNonRoot
+	template.Spec.SecurityContext = &v1.PodSecurityContext{
+		RunAsUser:  security.RunAsUser,
+		RunAsGroup: security.RunAsGroup,
+		FSGroup:    security.FsGroup,
+	}
+	if runAsNonRoot {
+		template.Spec.SecurityContext.RunAsNonRoot = &runAsNonRoot
+	}
+
+	if profile := seccompAnnotation(security.SeccompProfile); len(profile) > 0 {
+		if template.ObjectMeta.Annotations == nil {
+			template.ObjectMeta.Annotations = map[string]string{}
+		}
+		template.ObjectMeta.Annotations[seccompPodAnnotation] = profile
+	}
+
+	for ix := range template.Spec.Containers {
+		readOnlyRootFilesystem := security.ReadOnlyRootFilesystem
+		context := &v1.SecurityContext{
+			RunAsUser:                security.RunAsUser,
+			RunAsGroup:               security.RunAsGroup,
+			AllowPrivilegeEscalation: security.AllowPrivilegeEscalation,
+			ReadOnlyRootFilesystem:   &readOnlyRootFilesystem,
+		}
+		if runAsNonRoot {
+			context.RunAsNonRoot = &runAsNonRoot
+		}
+		if len(security.DropCapabilities) > 0 {
+			context.Capabilities = &v1.Capabilities{}
+			for _, capability := range security.DropCapabilities {
+				context.Capabilities.Drop = append(context.Capabilities.Drop, v1.Capability(capability))
+			}
+		}
+		template.Spec.Containers[ix].SecurityContext = context
+	}
+}
+
+// seccompAnnotation translates a seccomp profile into the value of the pod annotation.
+func seccompAnnotation(profile string) string {
+	switch {
+	case profile == seccompRuntimeDefault:
+		return "runtime/default"
+	case profile == seccompUnconfined:
+		return "unconfined"
+	case strings.HasPrefix(profile, seccompLocalhost):
+		return profile
+	}
+	return ""
+}
+
+// hasSeccomp returns true if any service or job of a service sets a seccomp profile.
+func hasSeccomp(svc *models.Service) bool {
+	for _, s := range svc.Services {
+		if s.SecurityContext != nil && len(s.SecurityContext.SeccompProfile) > 0 {
+			return true
+		}
+	}
+	for _, j := range svc.Jobs {
+		if j.SecurityContext != nil && len(j.SecurityContext.SeccompProfile) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// validateSeccompSupport checks that a cluster still honours the seccomp
+// annotation, rather than silently running the pods without a profile.
+func validateSeccompSupport(info *version.Info) error {
+	// managed clusters report versions like 1.26+
+	minor, err := strconv.Atoi(strings.TrimRight(info.Minor, "+"))
+	if err != nil || info.Major != "1" {
+		return fmt.Errorf("seccompProfile: unknown Kubernetes version %s.%s", info.Major, info.Minor)
+	}
+	if minor > seccompAnnotationLastMinor {
+		return fmt.Errorf("seccompProfile is set with the seccomp annotation, which Kubernetes %s.%s ignores; this version of the compiler can't set the seccompProfile field, so it only supports seccompProfile on clusters up to 1.%d, leave it out and set the profile with the cluster's defaults instead", info.Major, info.Minor, seccompAnnotationLastMinor)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package compiler
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+func TestApplySecurity(t *testing.T) {
+	tests := []struct {
+		name       string
+		security   string
+		annotation string
+	}{
+		{name: "none", security: `{}`},
+		{name: "runtime default", security: `{"seccompProfile": "RuntimeDefault"}`, annotation: "runtime/default"},
+		{name: "unconfined", security: `{"seccompProfile": "Unconfined"}`, annotation: "unconfined"},
+		{name: "localhost", security: `{"seccompProfile": "localhost/profiles/audit.json"}`, annotation: "localhost/profiles/audit.json"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := parseService(t, `{"name": "app", "services": [{"name": "web", "securityContext": `+test.security+`}]}`)
+			template := &v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{Name: "web"}, {Name: "sidecar"}},
+				},
+			}
+			applySecurity(template, svc.Services[0].SecurityContext, "deployer")
+			if template.Spec.ServiceAccountName != "deployer" {
+				t.Errorf("unexpected service account %s", template.Spec.ServiceAccountName)
+			}
+			if annotation := template.Annotations[seccompPodAnnotation]; annotation != test.annotation {
+				t.Errorf("annotation = %q, expected %q", annotation, test.annotation)
+			}
+			for _, container := range template.Spec.Containers {
+				if container.SecurityContext == nil {
+					t.Errorf("%s has no security context", container.Name)
+				}
+			}
+			if hasSeccomp(svc) != (len(test.annotation) > 0) {
+				t.Errorf("hasSeccomp = %v", hasSeccomp(svc))
+			}
+		})
+	}
+}
+
+func TestValidateSeccompSupport(t *testing.T) {
+	tests := []struct {
+		major string
+		minor string
+		err   string
+	}{
+		{major: "1", minor: "17"},
+		{major: "1", minor: "26"},
+		{major: "1", minor: "26+"},
+		{major: "1", minor: "27", err: "can't set the seccompProfile field"},
+		{major: "1", minor: "30+", err: "ignores"},
+		{major: "1", minor: "", err: "unknown Kubernetes version"},
+		{major: "2", minor: "0", err: "unknown Kubernetes version"},
+	}
+	for _, test := range tests {
+		err := validateSeccompSupport(&version.Info{Major: test.major, Minor: test.minor})
+		if len(test.err) == 0 {
+			if err != nil {
+				t.Errorf("%s.%s: unexpected error: %v", test.major, test.minor, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s.%s: expected an error containing %q, got %v", test.major, test.minor, test.err, err)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package compiler
+
+import (
+	"fmt"
+
+	"github.com/metaparticle-io/metaparticle-ast/models"
+	batch "k8s.io/api/batch/v1"
+	"k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func (k *kubernetesCompiler) Status(svc *models.Service) (*models.Status, error) {
+	status := newStatus(svc, "kubernetes")
+	ingressAddresses := []string{}
+	if hasIngress(svc) {
+		ingress, err := k.clientset.ExtensionsV1beta1().Ingresses("default").Get(*svc.Name, meta.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		if err == nil {
+			ingressAddresses = loadBalancerAddresses(ingress.Status.LoadBalancer)
+		}
+	}
+	for _, service := range svc.Services {
+		serviceStatus, err := k.serviceStatus(svc, service, ingressAddresses)
+		if err != nil {
+			return nil, err
+		}
+		status.Services = append(status.Services, serviceStatus)
+	}
+	for _, job := range svc.Jobs {
+		jobStatus, err := k.jobStatus(job)
+		if err != nil {
+			return nil, err
+		}
+		status.Jobs = append(status.Jobs, jobStatus)
+	}
+	return status, nil
+}
+
+func (k *kubernetesCompiler) serviceStatus(svc *models.Service, service *models.ServiceSpecification, ingressAddresses []string) (*models.ServiceStatus, error) {
+	name := *service.Name
+	status := newServiceStatus(service)
+
+	pods, err := k.pods(name)
+	if err != nil {
+		return nil, err
+	}
+	if service.ShardSpec != nil {
+		set, err := k.clientset.AppsV1beta1().StatefulSets("default").Get(name, meta.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			status.Failures = addFailure(status.Failures, fmt.Sprintf("StatefulSet %s doesn't exist", name))
+		case err != nil:
+			return nil, err
+		default:
+			status.ReadyReplicas = set.Status.ReadyReplicas
+		}
+
+		ready := map[string]bool{}
+		for ix := range pods {
+			ready[pods[ix].Name] = isPodReady(&pods[ix])
+		}
+		status.Shards = models.ServiceStatusShards{}
+		for ix := int32(0); ix < service.ShardSpec.Shards; ix++ {
+			status.Shards = append(status.Shards, &models.ShardStatus{
+				Index: ix,
+				Ready: ready[fmt.Sprintf("%s-%d", name, ix)],
+			})
+		}
+
+		sharderName := makeSharderName(name)
+		status.Sharder = &models.SharderStatus{
+			Replicas: sharderReplicas(service.ShardSpec),
+		}
+		sharder, err := k.clientset.ExtensionsV1beta1().Deployments("default").Get(sharderName, meta.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			status.Failures = addFailure(status.Failures, fmt.Sprintf("Deployment %s doesn't exist", sharderName))
+		case err != nil:
+			return nil, err
+		default:
+			status.Sharder.ReadyReplicas = sharder.Status.ReadyReplicas
+		}
+		sharderPods, err := k.pods(sharderName)
+		if err != nil {
+			return nil, err
+		}
+		pods = append(pods, sharderPods...)
+	} else if service.Replicas > 0 {
+		deployment, err := k.clientset.ExtensionsV1beta1().Deployments("default").Get(name, meta.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			status.Failures = addFailure(status.Failures, fmt.Sprintf("Deployment %s doesn't exist", name))
+		case err != nil:
+			return nil, err
+		default:
+			status.ReadyReplicas = deployment.Status.ReadyReplicas
+		}
+	}
+	for ix := range pods {
+		status.Failures = podFailures(status.Failures, &pods[ix])
+	}
+
+	if isPublic(svc, name) {
+		lb, err := k.clientset.CoreV1().Services("default").Get(backendServiceName(service), meta.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		if err == nil {
+			for _, address := range loadBalancerAddresses(lb.Status.LoadBalancer) {
+				for _, port := range lb.Spec.Ports {
+					status.Endpoints = append(status.Endpoints, fmt.Sprintf("%s:%d", address, port.Port))
+				}
+			}
+		}
+	}
+	status.Endpoints = append(status.Endpoints, ingressEndpoints(svc, name, ingressAddresses)...)
+	return status, nil
+}
+
+func (k *kubernetesCompiler) jobStatus(job *models.JobSpecification) (*models.JobStatus, error) {
+	status := newJobStatus(job)
+	for _, name := range kubernetesJobNames(job) {
+		kubeJob, err := k.clientset.BatchV1().Jobs("default").Get(name, meta.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			status.Failures = addFailure(status.Failures, fmt.Sprintf("Job %s doesn't exist", name))
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		status.Active += kubeJob.Status.Active
+		status.Succeeded += kubeJob.Status.Succeeded
+		status.Failed += kubeJob.Status.Failed
+		for _, condition := range kubeJob.Status.Conditions {
+			if condition.Type == batch.JobFailed && condition.Status == v1.ConditionTrue {
+				status.Failures = addFailure(status.Failures, fmt.Sprintf("%s: %s: %s", name, condition.Reason, condition.Message))
+			}
+		}
+	}
+	pods, err := k.pods(*job.Name)
+	if err != nil {
+		return nil, err
+	}
+	for ix := range pods {
+		status.Failures = podFailures(status.Failures, &pods[ix])
+	}
+	return status, nil
+}
+
+// pods returns the pods with the app label of a service, sharder or job.
+func (k *kubernetesCompiler) pods(app string) ([]v1.Pod, error) {
+	pods, err := k.clientset.CoreV1().Pods("default").List(meta.ListOptions{
+		LabelSelector: "app=" + app,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pods.Items, nil
+}
+
+func isPodReady(pod *v1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodReady {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// podFailures adds the reasons a pod can't be scheduled, or its containers
+// are failing, to failures.
+func podFailures(failures []string, pod *v1.Pod) []string {
+	if len(pod.Status.Reason) > 0 {
+		failures = addFailure(failures, fmt.Sprintf("%s: %s", pod.Name, pod.Status.Reason))
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodScheduled && condition.Status == v1.ConditionFalse {
+			failures = addFailure(failures, fmt.Sprintf("%s: %s: %s", pod.Name, condition.Reason, condition.Message))
+		}
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		if waiting := status.State.Waiting; waiting != nil && len(waiting.Reason) > 0 && waiting.Reason != "ContainerCreating" {
+			failures = addFailure(failures, fmt.Sprintf("%s/%s: %s", pod.Name, status.Name, waiting.Reason))
+		}
+		terminated := status.State.Terminated
+		if terminated == nil {
+			terminated = status.LastTerminationState.Terminated
+		}
+		if terminated != nil && terminated.ExitCode != 0 {
+			failures = addFailure(failures, fmt.Sprintf("%s/%s: %s (exit code %d)", pod.Name, status.Name, terminated.Reason, terminated.ExitCode))
+		}
+	}
+	return failures
+}
+
+// loadBalancerAddresses returns the IP addresses or hostnames of a load balancer.
+func loadBalancerAddresses(status v1.LoadBalancerStatus) []string {
+	addresses := []string{}
+	for _, ingress := range status.Ingress {
+		if len(ingress.Hostname) > 0 {
+			addresses = append(addresses, ingress.Hostname)
+		} else if len(ingress.IP) > 0 {
+			addresses = append(addresses, ingress.IP)
+		}
+	}
+	return addresses
+}
+
+// ingressEndpoints returns the URLs that the Ingress routes to the named
+// sub-service. Routes without hostnames are reached on the addresses of the
+// Ingress itself.
+func ingressEndpoints(svc *models.Service, name string, addresses []string) []string {
+	endpoints := []string{}
+	for _, serve := range serveSpecifications(svc) {
+		if !isIngress(serve) {
+			continue
+		}
+		scheme := "http"
+		if len(serve.TLSSecret) > 0 {
+			scheme = "https"
+		}
+		hosts := serve.Hostnames
+		if len(hosts) == 0 {
+			hosts = addresses
+		}
+		for _, path := range servePaths(serve) {
+			if *path.Service != name {
+				continue
+			}
+			for _, host := range hosts {
+				endpoints = append(endpoints, fmt.Sprintf("%s://%s%s", scheme, host, *path.Path))
+			}
+		}
+	}
+	return endpoints
+}
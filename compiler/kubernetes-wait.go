@@ -0,0 +1,173 @@
+package compiler
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/metaparticle-io/metaparticle-ast/ktail"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+	batch "k8s.io/api/batch/v1"
+	"k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// kubernetesJobNames returns the names of the Kubernetes jobs created for a job.
+func kubernetesJobNames(job *models.JobSpecification) []string {
+	if !job.Indexed {
+		return []string{*job.Name}
+	}
+	names := []string{}
+	for ix := int32(0); ix < jobCompletions(job); ix++ {
+		names = append(names, jobInstanceName(job, ix))
+	}
+	return names
+}
+
+func (k *kubernetesCompiler) Wait(svc *models.Service, timeout time.Duration, stdout, stderr io.Writer) ([]*JobResult, error) {
+	// the logs are followed until the jobs finished, and no line is written
+	// after Wait returns
+	stop := make(chan struct{})
+	var mutex sync.Mutex
+	var wait sync.WaitGroup
+	defer func() {
+		close(stop)
+		wait.Wait()
+	}()
+	for _, job := range svc.Jobs {
+		wait.Add(1)
+		go func(name string) {
+			defer wait.Done()
+			k.tailJob(name, stop, &mutex, stdout, stderr)
+		}(*job.Name)
+	}
+
+	names := []string{}
+	for _, job := range svc.Jobs {
+		names = append(names, kubernetesJobNames(job)...)
+	}
+	seen := map[string]bool{}
+	finished := map[string]bool{}
+	failed := []string{}
+	err := poll(timeout, func() (bool, error) {
+		for _, name := range names {
+			if finished[name] {
+				continue
+			}
+			job, err := k.clientset.BatchV1().Jobs("default").Get(name, meta.GetOptions{})
+			done, failure, err := jobProgress(job, err, seen[name])
+			if err != nil {
+				return false, err
+			}
+			seen[name] = true
+			if done {
+				finished[name] = true
+			}
+			if len(failure) > 0 {
+				failed = append(failed, fmt.Sprintf("%s (%s)", name, failure))
+			}
+		}
+		return len(finished) == len(names), nil
+	})
+
+	results, resultsErr := k.jobResults(svc)
+	if err != nil {
+		return results, err
+	}
+	if resultsErr != nil {
+		return results, resultsErr
+	}
+	return results, failedJobs(failed)
+}
+
+// jobProgress returns whether the job returned by a lookup finished, and the
+// reason it failed if it did. A job that disappears after it was seen was
+// deleted, by hand or by a ttlSecondsAfterFinished shorter than the poll
+// interval, and its outcome is lost along with its pods, so it fails.
+func jobProgress(job *batch.Job, err error, seen bool) (bool, string, error) {
+	if apierrors.IsNotFound(err) && seen {
+		return true, removedReason, nil
+	}
+	if err != nil {
+		return false, "", err
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != v1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batch.JobComplete:
+			return true, "", nil
+		case batch.JobFailed:
+			if len(condition.Reason) == 0 {
+				return true, string(condition.Type), nil
+			}
+			return true, condition.Reason, nil
+		}
+	}
+	return false, "", nil
+}
+
+// tailJob streams the logs of the pods of a job until stop is closed.
+func (k *kubernetesCompiler) tailJob(name string, stop <-chan struct{}, mutex *sync.Mutex, stdout, stderr io.Writer) {
+	selector := labels.Set{
+		"app": name,
+	}.AsSelectorPreValidated()
+	controller := ktail.NewController(k.clientset, v1.NamespaceDefault, selector,
+		ktail.Callbacks{
+			OnEvent: func(event ktail.LogEvent) {
+				mutex.Lock()
+				defer mutex.Unlock()
+				fmt.Fprintf(stdout, "%s:%s %s\n", event.Pod.Name, event.Container.Name, event.Message)
+			},
+			OnEnter: func(pod *v1.Pod, container *v1.Container) bool {
+				return true
+			},
+			OnExit: func(pod *v1.Pod, container *v1.Container) {},
+			OnError: func(pod *v1.Pod, container *v1.Container, err error) {
+				mutex.Lock()
+				defer mutex.Unlock()
+				fmt.Fprintf(stderr, "Error while tailing %s:%s: %v\n", pod.Name, container.Name, err)
+			},
+		})
+	controller.RunUntil(stop)
+}
+
+// jobResults reports the outcome of every pod that ran a job.
+func (k *kubernetesCompiler) jobResults(svc *models.Service) ([]*JobResult, error) {
+	results := []*JobResult{}
+	for _, job := range svc.Jobs {
+		pods, err := k.clientset.CoreV1().Pods("default").List(meta.ListOptions{
+			LabelSelector: "app=" + *job.Name,
+		})
+		if err != nil {
+			return results, err
+		}
+		for _, pod := range pods.Items {
+			result := &JobResult{
+				Job:       *job.Name,
+				Name:      pod.Name,
+				Succeeded: pod.Status.Phase == v1.PodSucceeded,
+				Reason:    pod.Status.Reason,
+			}
+			for _, status := range pod.Status.ContainerStatuses {
+				terminated := status.State.Terminated
+				if terminated == nil {
+					// restarted containers keep the previous exit in LastTerminationState
+					terminated = status.LastTerminationState.Terminated
+				}
+				if terminated != nil && terminated.ExitCode != 0 {
+					result.ExitCode = terminated.ExitCode
+					if len(result.Reason) == 0 {
+						result.Reason = terminated.Reason
+					}
+				}
+			}
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,53 @@
+package compiler
+
+import (
+	"fmt"
+	"testing"
+
+	batch "k8s.io/api/batch/v1"
+	"k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestJobProgress(t *testing.T) {
+	condition := func(conditionType batch.JobConditionType, status v1.ConditionStatus, reason string) *batch.Job {
+		return &batch.Job{
+			Status: batch.JobStatus{
+				Conditions: []batch.JobCondition{
+					{Type: conditionType, Status: status, Reason: reason},
+				},
+			},
+		}
+	}
+	notFound := apierrors.NewNotFound(schema.GroupResource{Group: "batch", Resource: "jobs"}, "migrate")
+	tests := []struct {
+		name     string
+		job      *batch.Job
+		err      error
+		seen     bool
+		finished bool
+		failure  string
+		fails    bool
+	}{
+		{name: "running", job: &batch.Job{}},
+		{name: "complete", job: condition(batch.JobComplete, v1.ConditionTrue, ""), finished: true},
+		{name: "failed", job: condition(batch.JobFailed, v1.ConditionTrue, "BackoffLimitExceeded"), finished: true, failure: "BackoffLimitExceeded"},
+		{name: "failed without reason", job: condition(batch.JobFailed, v1.ConditionTrue, ""), finished: true, failure: "Failed"},
+		{name: "condition not true", job: condition(batch.JobComplete, v1.ConditionFalse, "")},
+		{name: "removed after it was seen", err: notFound, seen: true, finished: true, failure: removedReason},
+		{name: "never seen", err: notFound, fails: true},
+		{name: "lookup failed", err: fmt.Errorf("connection refused"), seen: true, fails: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			finished, failure, err := jobProgress(test.job, test.err, test.seen)
+			if test.fails != (err != nil) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if finished != test.finished || failure != test.failure {
+				t.Errorf("got %v, %q, expected %v, %q", finished, failure, test.finished, test.failure)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package compiler
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+)
+
+// LogOptions select the log lines that StreamLogs reads.
+type LogOptions struct {
+	// Follow keeps streaming new lines until the stream is stopped.
+	Follow bool
+	// Container limits the lines to the sub-service or job with this name.
+	Container string
+	// Since skips the lines written before it, unless it is zero.
+	Since time.Time
+}
+
+// logSource is a container with the sub-service or job that runs it.
+type logSource struct {
+	service   string
+	container string
+}
+
+// logSources returns the docker and ACI containers of the sub-services and
+// jobs selected by opts.
+func logSources(svc *models.Service, opts *LogOptions) []logSource {
+	sources := []logSource{}
+	for _, service := range svc.Services {
+		if len(opts.Container) == 0 || opts.Container == *service.Name {
+			sources = append(sources, logSource{*service.Name, *service.Name})
+		}
+	}
+	for _, job := range svc.Jobs {
+		if len(opts.Container) > 0 && opts.Container != *job.Name {
+			continue
+		}
+		for ix := int32(0); ix < jobCompletions(job); ix++ {
+			sources = append(sources, logSource{*job.Name, jobInstanceName(job, ix)})
+		}
+	}
+	return sources
+}
+
+// newLogEvent creates the event for a log line. Lines that start with an
+// RFC 3339 timestamp, as written by docker logs --timestamps, are split
+// into the time and the message.
+func newLogEvent(source logSource, line string) *models.LogEvent {
+	line = strings.TrimRight(line, "\r\n")
+	event := &models.LogEvent{
+		Service:   source.service,
+		Container: source.container,
+	}
+	if parts := strings.SplitN(line, " ", 2); len(parts) == 2 {
+		if t, err := time.Parse(time.RFC3339Nano, parts[0]); err == nil {
+			event.Timestamp = strfmt.DateTime(t)
+			line = parts[1]
+		}
+	}
+	event.Message = &line
+	return event
+}
+
+// streamCommands runs the command that prints the log of every source,
+// passing their lines to handle one at a time. The commands are killed when
+// stop is closed. The first command that fails is reported.
+func streamCommands(sources []logSource, stop <-chan struct{}, handle func(*models.LogEvent), command func(source logSource) []string) error {
+	var lock sync.Mutex
+	var wait sync.WaitGroup
+	errs := make(chan error, len(sources))
+	for _, source := range sources {
+		wait.Add(1)
+		go func(source logSource) {
+			defer wait.Done()
+			errs <- executeCommandLines(command(source), stop, func(line string) {
+				lock.Lock()
+				defer lock.Unlock()
+				handle(newLogEvent(source, line))
+			})
+		}(source)
+	}
+	wait.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package compiler
+
+import (
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/metaparticle-io/metaparticle-ast/models"
+)
+
+func TestLogSources(t *testing.T) {
+	svc := parseService(t, `{"name": "app",
+		"services": [{"name": "web"}, {"name": "api"}],
+		"jobs": [{"name": "migrate"}, {"name": "render", "replicas": 2, "indexed": true}]
+	}`)
+	tests := []struct {
+		container string
+		expected  string
+	}{
+		{expected: "web/web api/api migrate/migrate render/render-0 render/render-1"},
+		{container: "api", expected: "api/api"},
+		{container: "render", expected: "render/render-0 render/render-1"},
+		{container: "missing", expected: ""},
+	}
+	for _, test := range tests {
+		sources := []string{}
+		for _, source := range logSources(svc, &LogOptions{Container: test.container}) {
+			sources = append(sources, source.service+"/"+source.container)
+		}
+		if strings.Join(sources, " ") != test.expected {
+			t.Errorf("%q: got %v, expected %s", test.container, sources, test.expected)
+		}
+	}
+}
+
+func TestNewLogEvent(t *testing.T) {
+	tests := []struct {
+		line      string
+		message   string
+		timestamp string
+	}{
+		{line: "plain line\n", message: "plain line"},
+		{line: "2020-04-01T10:00:00.123456789Z started on :8080\r\n", message: "started on :8080", timestamp: "2020-04-01T10:00:00.123Z"},
+		{line: "not-a-time message", message: "not-a-time message"},
+		{line: "", message: ""},
+	}
+	for _, test := range tests {
+		event := newLogEvent(logSource{"web", "web-0"}, test.line)
+		if *event.Message != test.message || event.Service != "web" || event.Container != "web-0" {
+			t.Errorf("%q: unexpected event %v", test.line, event)
+		}
+		timestamp := ""
+		if !time.Time(event.Timestamp).IsZero() {
+			timestamp = event.Timestamp.String()
+		}
+		if timestamp != test.timestamp {
+			t.Errorf("%q: timestamp %q, expected %q", test.line, timestamp, test.timestamp)
+		}
+	}
+}
+
+func TestStreamCommands(t *testing.T) {
+	sources := []logSource{{"web", "web"}, {"api", "api"}}
+	lines := []string{}
+	err := streamCommands(sources, make(chan struct{}), func(event *models.LogEvent) {
+		lines = append(lines, event.Service+": "+*event.Message)
+	}, func(source logSource) []string {
+		return []string{"sh", "-c", "echo one " + source.container + "; echo two " + source.container}
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sort.Strings(lines)
+	if strings.Join(lines, "|") != "api: one api|api: two api|web: one web|web: two web" {
+		t.Errorf("unexpected lines %v", lines)
+	}
+
+	err = streamCommands(sources, make(chan struct{}), func(*models.LogEvent) {}, func(source logSource) []string {
+		return []string{"sh", "-c", "test " + source.container + " = web"}
+	})
+	if err == nil {
+		t.Errorf("expected the failing command to be reported")
+	}
+}
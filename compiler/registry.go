@@ -0,0 +1,79 @@
+package compiler
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+)
+
+// registryFor returns the credentials of the registry an image is pulled
+// from, or nil if the spec has none for it.
+func registryFor(svc *models.Service, image string) *models.RegistryCredential {
+	for _, registry := range svc.Registries {
+		if strings.HasPrefix(image, strings.TrimSuffix(*registry.Server, "/")+"/") {
+			return registry
+		}
+	}
+	return nil
+}
+
+// registryPassword reads the password of a registry from its environment
+// variable. Dry runs only print a reference to the variable.
+func registryPassword(registry *models.RegistryCredential, dryrun bool) (string, error) {
+	if dryrun {
+		return "$" + registry.PasswordEnv, nil
+	}
+	password := os.Getenv(registry.PasswordEnv)
+	if len(password) == 0 {
+		return "", fmt.Errorf("registry %s: %s is not set", *registry.Server, registry.PasswordEnv)
+	}
+	return password, nil
+}
+
+// registrySecretName returns the name of the docker-registry secret for the
+// ix'th registry of a service.
+func registrySecretName(svc *models.Service, ix int) string {
+	if len(svc.Registries[ix].SecretName) > 0 {
+		return svc.Registries[ix].SecretName
+	}
+	return fmt.Sprintf("%s-registry-%d", *svc.Name, ix)
+}
+
+// validateImages checks the registry credentials and image pull policies of a service.
+func validateImages(svc *models.Service) error {
+	servers := map[string]bool{}
+	for _, registry := range svc.Registries {
+		if err := registry.Validate(strfmt.Default); err != nil {
+			return fmt.Errorf("registry: %v", err)
+		}
+		if servers[*registry.Server] {
+			return fmt.Errorf("duplicate registry %s", *registry.Server)
+		}
+		servers[*registry.Server] = true
+		if len(registry.PasswordEnv) > 0 && len(registry.Username) == 0 {
+			return fmt.Errorf("registry %s: passwordEnv requires a username", *registry.Server)
+		}
+	}
+	check := func(owner string, containers []*models.Container) error {
+		for _, c := range containers {
+			if err := c.Validate(strfmt.Default); err != nil {
+				return fmt.Errorf("%s: %v", owner, err)
+			}
+		}
+		return nil
+	}
+	for _, s := range svc.Services {
+		if err := check(*s.Name, s.Containers); err != nil {
+			return err
+		}
+	}
+	for _, j := range svc.Jobs {
+		if err := check(*j.Name, j.Containers); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package compiler
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/api/core/v1"
+)
+
+const registrySpec = `{
+	"name": "app",
+	"registries": [
+		{"server": "registry.example.com", "dockerConfig": "/root/.docker/config.json"},
+		{"server": "example.azurecr.io/", "username": "deploy", "passwordEnv": "ACR_PASSWORD", "secretName": "acr"}
+	],
+	"services": [{"name": "web", "containers": [{"image": "registry.example.com/web:1"}]}]
+}`
+
+func TestRegistryFor(t *testing.T) {
+	svc := parseService(t, registrySpec)
+	tests := []struct {
+		image  string
+		server string
+	}{
+		{image: "registry.example.com/web:1", server: "registry.example.com"},
+		{image: "example.azurecr.io/team/api", server: "example.azurecr.io/"},
+		{image: "registry.example.com.evil/web", server: ""},
+		{image: "nginx", server: ""},
+	}
+	for _, test := range tests {
+		registry := registryFor(svc, test.image)
+		server := ""
+		if registry != nil {
+			server = *registry.Server
+		}
+		if server != test.server {
+			t.Errorf("registryFor(%s) = %q, expected %q", test.image, server, test.server)
+		}
+	}
+	for ix, expected := range []string{"app-registry-0", "acr"} {
+		if name := registrySecretName(svc, ix); name != expected {
+			t.Errorf("registrySecretName(%d) = %s, expected %s", ix, name, expected)
+		}
+	}
+}
+
+func TestRegistryPassword(t *testing.T) {
+	svc := parseService(t, registrySpec)
+	registry := svc.Registries[1]
+
+	t.Setenv("ACR_PASSWORD", "")
+	if password, err := registryPassword(registry, true); err != nil || password != "$ACR_PASSWORD" {
+		t.Errorf("dry run: got %q, %v", password, err)
+	}
+	if _, err := registryPassword(registry, false); err == nil || !strings.Contains(err.Error(), "ACR_PASSWORD is not set") {
+		t.Errorf("expected an error for an unset password, got %v", err)
+	}
+	t.Setenv("ACR_PASSWORD", "secret")
+	if password, err := registryPassword(registry, false); err != nil || password != "secret" {
+		t.Errorf("got %q, %v", password, err)
+	}
+}
+
+func TestValidateImages(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+		err  string
+	}{
+		{name: "valid", spec: registrySpec},
+		{
+			name: "duplicate registry",
+			spec: `{"name": "app", "registries": [{"server": "r.io"}, {"server": "r.io"}]}`,
+			err:  "duplicate registry r.io",
+		},
+		{
+			name: "password without username",
+			spec: `{"name": "app", "registries": [{"server": "r.io", "passwordEnv": "PASSWORD"}]}`,
+			err:  "passwordEnv requires a username",
+		},
+		{
+			name: "invalid pull policy",
+			spec: `{"name": "app", "jobs": [{"name": "migrate", "containers": [{"image": "migrate", "imagePullPolicy": "Sometimes"}]}]}`,
+			err:  "migrate:",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateImages(parseService(t, test.spec))
+			if len(test.err) == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("expected an error containing %q, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestCreateRegistrySecrets(t *testing.T) {
+	svc := parseService(t, registrySpec)
+	plan, out := dryRunPlan(svc)
+	if err := plan.createRegistrySecrets(svc, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.objects) != 1 {
+		t.Fatalf("expected only the secret of the docker config, got %v", out.objects)
+	}
+	secret, ok := out.objects["app-registry-0-secret"].(*v1.Secret)
+	if !ok {
+		t.Fatalf("expected a secret, got %v", out.objects)
+	}
+	if secret.Type != v1.SecretTypeDockerConfigJson || string(secret.Data[v1.DockerConfigJsonKey]) != "<redacted>" {
+		t.Errorf("expected a redacted docker config secret, got %v", secret)
+	}
+	pullSecrets := imagePullSecrets(svc)
+	if len(pullSecrets) != 2 || pullSecrets[0].Name != "app-registry-0" || pullSecrets[1].Name != "acr" {
+		t.Errorf("unexpected image pull secrets %v", pullSecrets)
+	}
+}
@@ -0,0 +1,44 @@
+package compiler
+
+import (
+	"fmt"
+
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+)
+
+const (
+	seccompRuntimeDefault = "RuntimeDefault"
+	seccompUnconfined     = "Unconfined"
+	seccompLocalhost      = "localhost/"
+)
+
+// validateSecurity checks the security context of a service or job.
+func validateSecurity(owner string, security *models.SecurityContext) error {
+	if security == nil {
+		return nil
+	}
+	if err := security.Validate(strfmt.Default); err != nil {
+		return fmt.Errorf("%s: %v", owner, err)
+	}
+	if security.RunAsNonRoot && security.RunAsUser != nil && *security.RunAsUser == 0 {
+		return fmt.Errorf("%s: runAsNonRoot can't be combined with runAsUser 0", owner)
+	}
+	return nil
+}
+
+// hasSecurity returns true if any service or job of a service sets a
+// security context or a service account.
+func hasSecurity(svc *models.Service) bool {
+	for _, s := range svc.Services {
+		if s.SecurityContext != nil || len(s.ServiceAccountName) > 0 {
+			return true
+		}
+	}
+	for _, j := range svc.Jobs {
+		if j.SecurityContext != nil || len(j.ServiceAccountName) > 0 {
+			return true
+		}
+	}
+	return false
+}
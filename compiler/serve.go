@@ -0,0 +1,147 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/metaparticle-io/metaparticle-ast/models"
+)
+
+// serveSpecifications returns every serve entry of a service, the legacy
+// 'serve' field first.
+func serveSpecifications(svc *models.Service) []*models.ServeSpecification {
+	result := []*models.ServeSpecification{}
+	if svc.Serve != nil {
+		result = append(result, svc.Serve)
+	}
+	for _, serve := range svc.Serves {
+		if serve != nil {
+			result = append(result, serve)
+		}
+	}
+	return result
+}
+
+// isIngress returns true if the serve entry is routed through an ingress
+// rather than exposed with its own load balancer.
+func isIngress(serve *models.ServeSpecification) bool {
+	return len(serve.Hostnames) > 0 || len(serve.Paths) > 0
+}
+
+// hasIngress returns true if any serve entry of the service is routed through an ingress.
+func hasIngress(svc *models.Service) bool {
+	for _, serve := range serveSpecifications(svc) {
+		if isIngress(serve) {
+			return true
+		}
+	}
+	return false
+}
+
+// isPublic returns true if the named sub-service should get a public load balancer.
+func isPublic(svc *models.Service, name string) bool {
+	for _, serve := range serveSpecifications(svc) {
+		if *serve.Name == name && serve.Public && !isIngress(serve) {
+			return true
+		}
+	}
+	return false
+}
+
+// isRouted returns true if an ingress routes traffic to the named sub-service.
+func isRouted(svc *models.Service, name string) bool {
+	for _, serve := range serveSpecifications(svc) {
+		if !isIngress(serve) {
+			continue
+		}
+		if *serve.Name == name && len(serve.Paths) == 0 {
+			return true
+		}
+		for _, path := range serve.Paths {
+			if *path.Service == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isServed returns true if the named sub-service receives traffic from
+// outside the application, either through a load balancer or an ingress.
+func isServed(svc *models.Service, name string) bool {
+	return isPublic(svc, name) || isRouted(svc, name)
+}
+
+// servePaths returns the path routes of a serve entry, everything goes to
+// the named service if no paths are given.
+func servePaths(serve *models.ServeSpecification) []*models.ServePath {
+	if len(serve.Paths) > 0 {
+		return serve.Paths
+	}
+	path := "/"
+	return []*models.ServePath{
+		&models.ServePath{
+			Path:    &path,
+			Service: serve.Name,
+		},
+	}
+}
+
+// servePort returns the port requests for a path are routed to.
+func servePort(svc *models.Service, path *models.ServePath) (int32, error) {
+	target := findService(svc, *path.Service)
+	if target == nil {
+		return 0, fmt.Errorf("serve path %s refers to unknown service %s", *path.Path, *path.Service)
+	}
+	ports := target.Ports
+	if target.ShardSpec != nil {
+		// only sharded ports are reachable through the sharder
+		ports = shardedPorts(target)
+	}
+	if len(ports) == 0 {
+		return 0, fmt.Errorf("serve path %s: service %s has no ports", *path.Path, *path.Service)
+	}
+	if path.Port == 0 {
+		return *ports[0].Number, nil
+	}
+	for _, port := range ports {
+		if *port.Number == path.Port {
+			return path.Port, nil
+		}
+	}
+	return 0, fmt.Errorf("serve path %s: service %s has no port %d", *path.Path, *path.Service, path.Port)
+}
+
+// validateServe checks that every serve entry refers to existing services and ports.
+func validateServe(svc *models.Service) error {
+	ingressClass := ""
+	for _, serve := range serveSpecifications(svc) {
+		if findService(svc, *serve.Name) == nil {
+			return fmt.Errorf("serve refers to unknown service %s", *serve.Name)
+		}
+		if !isIngress(serve) {
+			if len(serve.TLSSecret) > 0 || len(serve.IngressClass) > 0 {
+				return fmt.Errorf("serve %s: tlsSecret and ingressClass require hostnames or paths", *serve.Name)
+			}
+			continue
+		}
+		if len(serve.IngressClass) > 0 {
+			if len(ingressClass) > 0 && ingressClass != serve.IngressClass {
+				return fmt.Errorf("serve %s: conflicting ingress classes %s and %s", *serve.Name, ingressClass, serve.IngressClass)
+			}
+			ingressClass = serve.IngressClass
+		}
+		if len(serve.TLSSecret) > 0 && len(serve.Hostnames) == 0 {
+			return fmt.Errorf("serve %s: tlsSecret requires hostnames", *serve.Name)
+		}
+		for _, path := range servePaths(serve) {
+			if !strings.HasPrefix(*path.Path, "/") {
+				return fmt.Errorf("serve %s: path %s must start with '/'", *serve.Name, *path.Path)
+			}
+			if _, err := servePort(svc, path); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package compiler
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+)
+
+const (
+	defaultSharderImage = "brendanburns/sharder"
+	defaultSharderPort  = int32(8080)
+
+	shardKeyURL    = "url"
+	shardKeyField  = "field"
+	shardKeyHeader = "header"
+)
+
+var (
+	// fieldPathPattern matches the subset of JSONPath the sharder understands,
+	// e.g. '$.user.id' or 'users[0].name'.
+	fieldPathPattern = regexp.MustCompile(`^\$?(\.?[A-Za-z_][A-Za-z0-9_-]*|\[[0-9]+\])+$`)
+	// headerPattern matches valid HTTP header names.
+	headerPattern = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+)
+
+// sharderImage returns the image of the sharder for a shard spec.
+func sharderImage(spec *models.ShardSpecification) string {
+	if spec.Sharder != nil && len(spec.Sharder.Image) > 0 {
+		return spec.Sharder.Image
+	}
+	return defaultSharderImage
+}
+
+// sharderPort returns the port the sharder listens on.
+func sharderPort(spec *models.ShardSpecification) int32 {
+	if spec.Sharder != nil && spec.Sharder.Port > 0 {
+		return spec.Sharder.Port
+	}
+	return defaultSharderPort
+}
+
+// sharderReplicas returns the number of sharder replicas, which defaults to
+// the number of shards.
+func sharderReplicas(spec *models.ShardSpecification) int32 {
+	if spec.Sharder != nil && spec.Sharder.Replicas > 0 {
+		return spec.Sharder.Replicas
+	}
+	return spec.Shards
+}
+
+// shardedPorts returns the ports of a service that are routed through the sharder.
+func shardedPorts(service *models.ServiceSpecification) []*models.ServicePort {
+	ports := []*models.ServicePort{}
+	for _, port := range service.Ports {
+		if !port.Direct {
+			ports = append(ports, port)
+		}
+	}
+	return ports
+}
+
+// sharderListenPort returns the port the sharder listens on for the ix'th
+// sharded port. The first one uses the configured sharder port, the others
+// keep their own port number.
+func sharderListenPort(service *models.ServiceSpecification, ix int) int32 {
+	if ix == 0 {
+		return sharderPort(service.ShardSpec)
+	}
+	return *shardedPorts(service)[ix].Number
+}
+
+// shardPortKey returns the suffix of the per-port sharder settings.
+func shardPortKey(port *models.ServicePort) string {
+	if len(port.Name) == 0 {
+		return strconv.Itoa(int(*port.Number))
+	}
+	return strings.ToUpper(strings.Replace(port.Name, "-", "_", -1))
+}
+
+// sharderHashing returns the hashing algorithm of the sharder, or the empty
+// string if the spec leaves it up to the sharder.
+func sharderHashing(spec *models.ShardSpecification) string {
+	if spec.Sharder != nil {
+		return spec.Sharder.Hashing
+	}
+	return ""
+}
+
+// shardKeySource returns where the sharder extracts the shard key from, or
+// the empty string if the spec leaves it up to the sharder.
+func shardKeySource(spec *models.ShardSpecification) string {
+	switch {
+	case len(spec.URLPattern) > 0:
+		return shardKeyURL
+	case len(spec.FieldPath) > 0:
+		return shardKeyField
+	case len(spec.Header) > 0:
+		return shardKeyHeader
+	}
+	return ""
+}
+
+// validateShardSpec checks the ports and key extraction rules of a sharded service.
+func validateShardSpec(service *models.ServiceSpecification) error {
+	spec := service.ShardSpec
+	if err := spec.Validate(strfmt.Default); err != nil {
+		return fmt.Errorf("%s: %v", *service.Name, err)
+	}
+	sharded := shardedPorts(service)
+	if len(sharded) == 0 {
+		return fmt.Errorf("%s: sharded services need at least one port that isn't direct", *service.Name)
+	}
+	keys := map[string]bool{}
+	for ix, port := range sharded {
+		key := shardPortKey(port)
+		if keys[key] {
+			return fmt.Errorf("%s: duplicate sharded port %s", *service.Name, key)
+		}
+		keys[key] = true
+		if ix > 0 && *port.Number == sharderPort(spec) {
+			return fmt.Errorf("%s: port %d collides with the sharder port", *service.Name, *port.Number)
+		}
+	}
+	sources := 0
+	for _, value := range []string{spec.URLPattern, spec.FieldPath, spec.Header} {
+		if len(value) > 0 {
+			sources++
+		}
+	}
+	if sources > 1 {
+		return fmt.Errorf("%s: only one of urlPattern, fieldPath and header may be set", *service.Name)
+	}
+	if len(spec.URLPattern) > 0 {
+		re, err := regexp.Compile(spec.URLPattern)
+		if err != nil {
+			return fmt.Errorf("%s: invalid urlPattern: %v", *service.Name, err)
+		}
+		if re.NumSubexp() == 0 {
+			return fmt.Errorf("%s: urlPattern %q needs a capture group for the shard key", *service.Name, spec.URLPattern)
+		}
+	}
+	if len(spec.FieldPath) > 0 && !fieldPathPattern.MatchString(spec.FieldPath) {
+		return fmt.Errorf("%s: invalid fieldPath %q", *service.Name, spec.FieldPath)
+	}
+	if len(spec.Header) > 0 && !headerPattern.MatchString(spec.Header) {
+		return fmt.Errorf("%s: invalid header name %q", *service.Name, spec.Header)
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+package compiler
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/api/extensions/v1beta1"
+)
+
+// shardedService returns the single sub-service of a sharded spec with the
+// given shardSpec fields.
+func shardedService(t *testing.T, shardSpec string, ports string) *kubernetesPlan {
+	t.Helper()
+	svc := parseService(t, `{"name": "app", "services": [{"name": "users", "ports": `+ports+`, "shardSpec": {"shards": 3`+shardSpec+`}}]}`)
+	plan, _ := dryRunPlan(svc)
+	return plan
+}
+
+func TestShardKeyEnv(t *testing.T) {
+	tests := []struct {
+		name      string
+		shardSpec string
+		expected  map[string]string
+	}{
+		{
+			name:      "url",
+			shardSpec: `, "urlPattern": "user/(.*)/"`,
+			expected:  map[string]string{"SHARD_KEY_SOURCE": "url", "SHARD_URL_PATTERN": "user/(.*)/"},
+		},
+		{
+			name:      "field",
+			shardSpec: `, "fieldPath": "$.user.id"`,
+			expected:  map[string]string{"SHARD_KEY_SOURCE": "field", "SHARD_FIELD_PATH": "$.user.id"},
+		},
+		{
+			name:      "header",
+			shardSpec: `, "header": "X-User"`,
+			expected:  map[string]string{"SHARD_KEY_SOURCE": "header", "SHARD_HEADER": "X-User"},
+		},
+		{
+			name:     "left to the sharder",
+			expected: map[string]string{"SHARD_KEY_SOURCE": ""},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := shardedService(t, test.shardSpec, `[{"number": 80}]`).service.Services[0]
+			env := envMap(sharderEnv(service))
+			for name, value := range test.expected {
+				if env[name] != value {
+					t.Errorf("%s = %q, expected %q", name, env[name], value)
+				}
+			}
+			if env["SHARD_ADDRESSES"] != "users-0.users:80,users-1.users:80,users-2.users:80" {
+				t.Errorf("unexpected shard addresses %q", env["SHARD_ADDRESSES"])
+			}
+		})
+	}
+}
+
+func TestValidateShardSpec(t *testing.T) {
+	tests := []struct {
+		name      string
+		shardSpec string
+		ports     string
+		err       string
+	}{
+		{name: "url", shardSpec: `, "urlPattern": "user/(.*)/"`},
+		{name: "field", shardSpec: `, "fieldPath": "users[0].name"`},
+		{name: "header", shardSpec: `, "header": "X-User"`},
+		{name: "two sources", shardSpec: `, "urlPattern": "user/(.*)/", "header": "X-User"`, err: "only one of"},
+		{name: "invalid pattern", shardSpec: `, "urlPattern": "user/(.*/"`, err: "invalid urlPattern"},
+		{name: "no capture group", shardSpec: `, "urlPattern": "user/.*/"`, err: "needs a capture group"},
+		{name: "invalid field path", shardSpec: `, "fieldPath": "user..id"`, err: "invalid fieldPath"},
+		{name: "invalid header", shardSpec: `, "header": "X User"`, err: "invalid header name"},
+		{name: "only direct ports", ports: `[{"number": 9100, "direct": true}]`, err: "at least one port"},
+		{name: "duplicate ports", ports: `[{"number": 80, "name": "http"}, {"number": 81, "name": "http"}]`, err: "duplicate sharded port HTTP"},
+		{name: "sharder port collision", ports: `[{"number": 80}, {"number": 8080}]`, err: "collides with the sharder port"},
+		{name: "several ports", ports: `[{"number": 80}, {"number": 81}, {"number": 9100, "direct": true}]`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ports := test.ports
+			if len(ports) == 0 {
+				ports = `[{"number": 80}]`
+			}
+			err := validateShardSpec(shardedService(t, test.shardSpec, ports).service.Services[0])
+			if len(test.err) == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("expected an error containing %q, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestSharderSettings(t *testing.T) {
+	tests := []struct {
+		name      string
+		shardSpec string
+		image     string
+		replicas  int32
+		port      int32
+		hashing   string
+	}{
+		{
+			name:     "defaults",
+			image:    defaultSharderImage,
+			replicas: 3,
+			port:     defaultSharderPort,
+		},
+		{
+			name:      "overrides",
+			shardSpec: `, "sharder": {"image": "example/sharder:1.2", "replicas": 2, "port": 9000, "hashing": "consistent"}`,
+			image:     "example/sharder:1.2",
+			replicas:  2,
+			port:      9000,
+			hashing:   "consistent",
+		},
+		{
+			name:      "partial",
+			shardSpec: `, "sharder": {"hashing": "rendezvous"}`,
+			image:     defaultSharderImage,
+			replicas:  3,
+			port:      defaultSharderPort,
+			hashing:   "rendezvous",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			plan := shardedService(t, test.shardSpec, `[{"number": 80}]`)
+			out := plan.opts.Output.(*recorder)
+			service := plan.service.Services[0]
+			if err := plan.deployStateful(service, nil); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			deployment, ok := out.objects["users-shardershard-router"].(*v1beta1.Deployment)
+			if !ok {
+				t.Fatalf("no sharder deployment in %v", out.objects)
+			}
+			if *deployment.Spec.Replicas != test.replicas {
+				t.Errorf("replicas = %d, expected %d", *deployment.Spec.Replicas, test.replicas)
+			}
+			container := deployment.Spec.Template.Spec.Containers[0]
+			if container.Image != test.image {
+				t.Errorf("image = %s, expected %s", container.Image, test.image)
+			}
+			if len(container.Ports) != 1 || container.Ports[0].ContainerPort != test.port {
+				t.Errorf("unexpected container ports %v, expected %d", container.Ports, test.port)
+			}
+			env := envMap(container.Env)
+			if env["SHARD_HASH_ALGORITHM"] != test.hashing {
+				t.Errorf("SHARD_HASH_ALGORITHM = %q, expected %q", env["SHARD_HASH_ALGORITHM"], test.hashing)
+			}
+			routerPorts := getSharderPorts(service)
+			if len(routerPorts) != 1 || routerPorts[0].Port != 80 || routerPorts[0].TargetPort.IntValue() != int(test.port) {
+				t.Errorf("unexpected router ports %v", routerPorts)
+			}
+		})
+	}
+}
+
+func TestShardedPorts(t *testing.T) {
+	tests := []struct {
+		name      string
+		ports     string
+		shardSpec string
+		env       map[string]string
+		listen    []int32
+	}{
+		{
+			name:   "single port",
+			ports:  `[{"number": 80}, {"number": 9100, "direct": true}]`,
+			env:    map[string]string{"SERVER_ADDRESS": "0.0.0.0:8080", "SHARD_PORTS": ""},
+			listen: []int32{8080},
+		},
+		{
+			name:  "named ports",
+			ports: `[{"number": 80, "name": "http"}, {"number": 9000, "name": "grpc-api"}, {"number": 9100, "direct": true}]`,
+			env: map[string]string{
+				"SHARD_PORTS":              "HTTP,GRPC_API",
+				"SHARD_ADDRESSES":          "users-0.users:80,users-1.users:80,users-2.users:80",
+				"SERVER_ADDRESS":           "0.0.0.0:8080",
+				"SHARD_ADDRESSES_HTTP":     "users-0.users:80,users-1.users:80,users-2.users:80",
+				"SERVER_ADDRESS_HTTP":      "0.0.0.0:8080",
+				"SHARD_ADDRESSES_GRPC_API": "users-0.users:9000,users-1.users:9000,users-2.users:9000",
+				"SERVER_ADDRESS_GRPC_API":  "0.0.0.0:9000",
+			},
+			listen: []int32{8080, 9000},
+		},
+		{
+			name:      "unnamed ports",
+			ports:     `[{"number": 80}, {"number": 81}]`,
+			shardSpec: `, "sharder": {"port": 7000}`,
+			env: map[string]string{
+				"SHARD_PORTS":        "80,81",
+				"SERVER_ADDRESS_80":  "0.0.0.0:7000",
+				"SERVER_ADDRESS_81":  "0.0.0.0:81",
+				"SHARD_ADDRESSES_81": "users-0.users:81,users-1.users:81,users-2.users:81",
+			},
+			listen: []int32{7000, 81},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := shardedService(t, test.shardSpec, test.ports).service.Services[0]
+			env := envMap(sharderEnv(service))
+			for name, value := range test.env {
+				if env[name] != value {
+					t.Errorf("%s = %q, expected %q", name, env[name], value)
+				}
+			}
+			sharded := shardedPorts(service)
+			routerPorts := getSharderPorts(service)
+			containerPorts := getSharderContainerPorts(service)
+			if len(sharded) != len(test.listen) || len(routerPorts) != len(test.listen) || len(containerPorts) != len(test.listen) {
+				t.Fatalf("expected %d sharded ports, got %d, %d router and %d container ports", len(test.listen), len(sharded), len(routerPorts), len(containerPorts))
+			}
+			for ix, listen := range test.listen {
+				if port := sharderListenPort(service, ix); port != listen {
+					t.Errorf("listen port %d = %d, expected %d", ix, port, listen)
+				}
+				if routerPorts[ix].Port != *sharded[ix].Number || routerPorts[ix].TargetPort.IntValue() != int(listen) {
+					t.Errorf("router port %d: unexpected %v", ix, routerPorts[ix])
+				}
+				if containerPorts[ix].ContainerPort != listen {
+					t.Errorf("container port %d = %d, expected %d", ix, containerPorts[ix].ContainerPort, listen)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package compiler
+
+import (
+	"fmt"
+
+	"github.com/metaparticle-io/metaparticle-ast/models"
+)
+
+// maxFailures is the number of failure reasons reported for a service or job.
+const maxFailures = 10
+
+// addFailure records why a container failed, skipping reasons that are
+// already known and keeping at most maxFailures of them.
+func addFailure(failures []string, failure string) []string {
+	if len(failures) >= maxFailures {
+		return failures
+	}
+	for _, known := range failures {
+		if known == failure {
+			return failures
+		}
+	}
+	return append(failures, failure)
+}
+
+// newStatus creates an empty status for a service on a backend.
+func newStatus(svc *models.Service, backend string) *models.Status {
+	return &models.Status{
+		Name:     svc.Name,
+		Backend:  backend,
+		Services: models.StatusServices{},
+		Jobs:     models.StatusJobs{},
+	}
+}
+
+// newServiceStatus creates the status of a sub-service with the number of
+// replicas, or shards, that it asks for.
+func newServiceStatus(service *models.ServiceSpecification) *models.ServiceStatus {
+	replicas := service.Replicas
+	if service.ShardSpec != nil {
+		replicas = service.ShardSpec.Shards
+	}
+	return &models.ServiceStatus{
+		Name:      service.Name,
+		Replicas:  replicas,
+		Endpoints: []string{},
+		Failures:  []string{},
+	}
+}
+
+// newJobStatus creates the status of a job with the runs it needs.
+func newJobStatus(job *models.JobSpecification) *models.JobStatus {
+	return &models.JobStatus{
+		Name:        job.Name,
+		Completions: jobCompletions(job),
+		Failures:    []string{},
+	}
+}
+
+// portEndpoints returns an address for every port of a service.
+func portEndpoints(address string, ports []*models.ServicePort) []string {
+	endpoints := []string{}
+	for _, port := range ports {
+		endpoints = append(endpoints, fmt.Sprintf("%s:%d", address, *port.Number))
+	}
+	return endpoints
+}
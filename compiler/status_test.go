@@ -0,0 +1,144 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"k8s.io/api/core/v1"
+)
+
+func TestAddFailure(t *testing.T) {
+	failures := []string{}
+	for ix := 0; ix < 2*maxFailures; ix++ {
+		failures = addFailure(failures, fmt.Sprintf("failure %d", ix%(maxFailures+2)))
+		failures = addFailure(failures, "failure 0")
+	}
+	if len(failures) != maxFailures {
+		t.Errorf("expected %d failures, got %v", maxFailures, failures)
+	}
+	seen := map[string]bool{}
+	for _, failure := range failures {
+		if seen[failure] {
+			t.Errorf("%s is reported twice", failure)
+		}
+		seen[failure] = true
+	}
+}
+
+func TestNewServiceStatus(t *testing.T) {
+	svc := parseService(t, `{"name": "app", "services": [
+		{"name": "web", "replicas": 3, "ports": [{"number": 80}, {"number": 443}]},
+		{"name": "users", "shardSpec": {"shards": 5}}
+	]}`)
+	if replicas := newServiceStatus(svc.Services[0]).Replicas; replicas != 3 {
+		t.Errorf("expected 3 replicas, got %d", replicas)
+	}
+	if replicas := newServiceStatus(svc.Services[1]).Replicas; replicas != 5 {
+		t.Errorf("expected a replica per shard, got %d", replicas)
+	}
+	endpoints := portEndpoints("10.0.0.1", svc.Services[0].Ports)
+	if strings.Join(endpoints, " ") != "10.0.0.1:80 10.0.0.1:443" {
+		t.Errorf("unexpected endpoints %v", endpoints)
+	}
+}
+
+func TestPodFailures(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   v1.PodStatus
+		expected []string
+		ready    bool
+	}{
+		{
+			name: "running",
+			status: v1.PodStatus{
+				Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}},
+				ContainerStatuses: []v1.ContainerStatus{
+					{Name: "web", State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}},
+				},
+			},
+			expected: []string{},
+			ready:    true,
+		},
+		{
+			name: "creating",
+			status: v1.PodStatus{
+				ContainerStatuses: []v1.ContainerStatus{
+					{Name: "web", State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ContainerCreating"}}},
+				},
+			},
+			expected: []string{},
+		},
+		{
+			name: "unschedulable",
+			status: v1.PodStatus{
+				Conditions: []v1.PodCondition{{Type: v1.PodScheduled, Status: v1.ConditionFalse, Reason: "Unschedulable", Message: "0/3 nodes are available"}},
+			},
+			expected: []string{"web-1: Unschedulable: 0/3 nodes are available"},
+		},
+		{
+			name: "crash looping",
+			status: v1.PodStatus{
+				ContainerStatuses: []v1.ContainerStatus{
+					{
+						Name:                 "web",
+						State:                v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+						LastTerminationState: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "Error", ExitCode: 2}},
+					},
+				},
+			},
+			expected: []string{"web-1/web: CrashLoopBackOff", "web-1/web: Error (exit code 2)"},
+		},
+		{
+			name:     "evicted",
+			status:   v1.PodStatus{Reason: "Evicted"},
+			expected: []string{"web-1: Evicted"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pod := &v1.Pod{Status: test.status}
+			pod.Name = "web-1"
+			failures := podFailures([]string{}, pod)
+			if strings.Join(failures, "|") != strings.Join(test.expected, "|") {
+				t.Errorf("got %q, expected %q", failures, test.expected)
+			}
+			if isPodReady(pod) != test.ready {
+				t.Errorf("isPodReady = %v, expected %v", isPodReady(pod), test.ready)
+			}
+		})
+	}
+}
+
+func TestStatusEndpoints(t *testing.T) {
+	addresses := loadBalancerAddresses(v1.LoadBalancerStatus{
+		Ingress: []v1.LoadBalancerIngress{{IP: "203.0.113.1"}, {Hostname: "lb.example.com", IP: "203.0.113.2"}, {}},
+	})
+	if strings.Join(addresses, " ") != "203.0.113.1 lb.example.com" {
+		t.Errorf("unexpected load balancer addresses %v", addresses)
+	}
+
+	svc := parseService(t, `{
+		"name": "shop",
+		"services": [{"name": "web", "ports": [{"number": 80}]}, {"name": "api", "ports": [{"number": 80}]}],
+		"serves": [
+			{"name": "web", "hostnames": ["example.com"], "tlsSecret": "tls"},
+			{"name": "api", "paths": [{"path": "/api", "service": "api"}]}
+		]
+	}`)
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{name: "web", expected: "https://example.com/"},
+		{name: "api", expected: "http://203.0.113.1/api http://lb.example.com/api"},
+		{name: "db", expected: ""},
+	}
+	for _, test := range tests {
+		endpoints := ingressEndpoints(svc, test.name, addresses)
+		if strings.Join(endpoints, " ") != test.expected {
+			t.Errorf("%s: got %v, expected %s", test.name, endpoints, test.expected)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/metaparticle-io/metaparticle-ast/models"
+)
+
+// ValidationError lists every problem found in a service.
+type ValidationError []error
+
+func (v ValidationError) Error() string {
+	messages := []string{}
+	for _, err := range v {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Validate checks the parts of a service that the schema can't express. The
+// returned error is a ValidationError with every problem found.
+func Validate(svc *models.Service) error {
+	problems := ValidationError{}
+	check := func(err error) {
+		if err != nil {
+			problems = append(problems, err)
+		}
+	}
+	// the other checks look into every service, job and list entry, so they
+	// only run once none are missing
+	for ix, service := range svc.Services {
+		if service == nil || service.Name == nil {
+			check(fmt.Errorf("services[%d]: a service with a name is required", ix))
+		}
+	}
+	for ix, job := range svc.Jobs {
+		if job == nil || job.Name == nil {
+			check(fmt.Errorf("jobs[%d]: a job with a name is required", ix))
+		}
+	}
+	problems = append(problems, nullEntries(svc)...)
+	if len(problems) > 0 {
+		return problems
+	}
+	for _, service := range svc.Services {
+		if service.Replicas > 0 && service.ShardSpec != nil {
+			check(fmt.Errorf("%s: Replicas and shards are mutually exclusive", *service.Name))
+		}
+		if service.ShardSpec != nil {
+			check(validateShardSpec(service))
+		}
+		check(validatePlacement(svc, *service.Name, service.Placement))
+		check(validateDisruptionBudget(service))
+		check(validateSecurity(*service.Name, service.SecurityContext))
+	}
+	for _, job := range svc.Jobs {
+		check(validatePlacement(svc, *job.Name, job.Placement))
+		check(validateJob(job))
+		check(validateSecurity(*job.Name, job.SecurityContext))
+	}
+	check(validateServe(svc))
+	check(validateImages(svc))
+	check(validateDepends(svc))
+	if len(problems) > 0 {
+		return problems
+	}
+	return nil
+}
+
+// nullEntries reports the null entries of the lists inside a service. The
+// generated validators skip them, so they are found here.
+func nullEntries(svc *models.Service) []error {
+	problems := []error{}
+	missing := func(owner string, list string, ix int) {
+		problems = append(problems, fmt.Errorf("%s%s[%d]: an entry is required", owner, list, ix))
+	}
+	containers := func(owner string, list []*models.Container) {
+		for ix, container := range list {
+			if container == nil {
+				missing(owner, "containers", ix)
+				continue
+			}
+			for jx, env := range container.Env {
+				if env == nil {
+					missing(owner, fmt.Sprintf("containers[%d].env", ix), jx)
+				}
+			}
+		}
+	}
+	placement := func(owner string, placement *models.PlacementSpecification) {
+		if placement == nil {
+			return
+		}
+		for ix, requirement := range placement.NodeAffinity {
+			if requirement == nil {
+				missing(owner, "placement.nodeAffinity", ix)
+			}
+		}
+		for ix, term := range placement.PodAffinity {
+			if term == nil {
+				missing(owner, "placement.podAffinity", ix)
+			}
+		}
+		for ix, term := range placement.PodAntiAffinity {
+			if term == nil {
+				missing(owner, "placement.podAntiAffinity", ix)
+			}
+		}
+		for ix, toleration := range placement.Tolerations {
+			if toleration == nil {
+				missing(owner, "placement.tolerations", ix)
+			}
+		}
+	}
+	paths := func(owner string, serve *models.ServeSpecification) {
+		for ix, path := range serve.Paths {
+			if path == nil {
+				missing(owner, "paths", ix)
+			}
+		}
+	}
+
+	for _, service := range svc.Services {
+		if service == nil || service.Name == nil {
+			continue
+		}
+		owner := *service.Name + ": "
+		containers(owner, service.Containers)
+		for ix, port := range service.Ports {
+			if port == nil {
+				missing(owner, "ports", ix)
+			}
+		}
+		placement(owner, service.Placement)
+	}
+	for _, job := range svc.Jobs {
+		if job == nil || job.Name == nil {
+			continue
+		}
+		owner := *job.Name + ": "
+		containers(owner, job.Containers)
+		placement(owner, job.Placement)
+	}
+	for ix, registry := range svc.Registries {
+		if registry == nil {
+			missing("", "registries", ix)
+		}
+	}
+	if svc.Serve != nil {
+		paths("serve.", svc.Serve)
+	}
+	for ix, serve := range svc.Serves {
+		if serve == nil {
+			missing("", "serves", ix)
+			continue
+		}
+		paths(fmt.Sprintf("serves[%d].", ix), serve)
+	}
+	return problems
+}
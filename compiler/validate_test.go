@@ -0,0 +1,130 @@
+package compiler
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     string
+		problems []string
+	}{
+		{
+			name: "valid",
+			spec: `{"name": "app", "services": [{"name": "web", "replicas": 2}], "jobs": [{"name": "migrate", "containers": [{"image": "migrate"}]}]}`,
+		},
+		{
+			name:     "null service",
+			spec:     `{"name": "app", "services": [{"name": "web"}, null]}`,
+			problems: []string{"services[1]: a service with a name is required"},
+		},
+		{
+			name:     "null job",
+			spec:     `{"name": "app", "jobs": [null]}`,
+			problems: []string{"jobs[0]: a job with a name is required"},
+		},
+		{
+			name:     "service without a name",
+			spec:     `{"name": "app", "services": [{"replicas": 1}], "jobs": [null], "serve": {"name": "web"}}`,
+			problems: []string{"services[0]: a service with a name is required", "jobs[0]: a job with a name is required"},
+		},
+		{
+			name:     "null container",
+			spec:     `{"name": "app", "services": [{"name": "web", "containers": [null]}]}`,
+			problems: []string{"web: containers[0]: an entry is required"},
+		},
+		{
+			name:     "null env",
+			spec:     `{"name": "app", "services": [{"name": "web", "containers": [{"image": "web", "env": [null]}]}]}`,
+			problems: []string{"web: containers[0].env[0]: an entry is required"},
+		},
+		{
+			name:     "null job container",
+			spec:     `{"name": "app", "jobs": [{"name": "migrate", "containers": [{"image": "migrate"}, null]}]}`,
+			problems: []string{"migrate: containers[1]: an entry is required"},
+		},
+		{
+			name:     "null port",
+			spec:     `{"name": "app", "services": [{"name": "web", "replicas": 2, "ports": [null]}]}`,
+			problems: []string{"web: ports[0]: an entry is required"},
+		},
+		{
+			name:     "null sharded port",
+			spec:     `{"name": "app", "services": [{"name": "web", "ports": [null], "shardSpec": {"shards": 2}}]}`,
+			problems: []string{"web: ports[0]: an entry is required"},
+		},
+		{
+			name:     "null registry",
+			spec:     `{"name": "app", "registries": [null]}`,
+			problems: []string{"registries[0]: an entry is required"},
+		},
+		{
+			name:     "null toleration",
+			spec:     `{"name": "app", "services": [{"name": "web", "placement": {"tolerations": [null]}}]}`,
+			problems: []string{"web: placement.tolerations[0]: an entry is required"},
+		},
+		{
+			name:     "null pod affinity",
+			spec:     `{"name": "app", "services": [{"name": "web", "placement": {"podAffinity": [null]}}]}`,
+			problems: []string{"web: placement.podAffinity[0]: an entry is required"},
+		},
+		{
+			name:     "null pod anti-affinity",
+			spec:     `{"name": "app", "jobs": [{"name": "migrate", "containers": [{"image": "migrate"}], "placement": {"podAntiAffinity": [null]}}]}`,
+			problems: []string{"migrate: placement.podAntiAffinity[0]: an entry is required"},
+		},
+		{
+			name:     "null node affinity",
+			spec:     `{"name": "app", "services": [{"name": "web", "placement": {"nodeAffinity": [null]}}]}`,
+			problems: []string{"web: placement.nodeAffinity[0]: an entry is required"},
+		},
+		{
+			name:     "null serve path",
+			spec:     `{"name": "app", "services": [{"name": "web"}], "serve": {"name": "web", "paths": [null]}}`,
+			problems: []string{"serve.paths[0]: an entry is required"},
+		},
+		{
+			name:     "null serves",
+			spec:     `{"name": "app", "services": [{"name": "web"}], "serves": [null]}`,
+			problems: []string{"serves[0]: an entry is required"},
+		},
+		{
+			name:     "null path of serves",
+			spec:     `{"name": "app", "services": [{"name": "web"}], "serves": [{"name": "web", "paths": [null]}]}`,
+			problems: []string{"serves[0].paths[0]: an entry is required"},
+		},
+		{
+			name: "several problems",
+			spec: `{"name": "app", "services": [{"name": "web", "replicas": 2, "ports": [{"number": 80}], "shardSpec": {"shards": 2}, "depends": "db"}]}`,
+			problems: []string{
+				"web: Replicas and shards are mutually exclusive",
+				"web: depends on unknown service db",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := Validate(parseService(t, test.spec))
+			if len(test.problems) == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			problems, ok := err.(ValidationError)
+			if !ok {
+				t.Fatalf("expected a ValidationError, got %v", err)
+			}
+			if len(problems) != len(test.problems) {
+				t.Fatalf("expected %d problems, got %v", len(test.problems), problems)
+			}
+			for ix, problem := range problems {
+				if !strings.Contains(problem.Error(), test.problems[ix]) {
+					t.Errorf("problem %d is %q, expected %q", ix, problem, test.problems[ix])
+				}
+			}
+		})
+	}
+}
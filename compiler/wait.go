@@ -0,0 +1,113 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/metaparticle-io/metaparticle-ast/models"
+)
+
+const pollInterval = 2 * time.Second
+
+// removedReason is the failure of a job run that disappeared before its
+// outcome was seen. It is counted as failed, since nothing says it succeeded.
+const removedReason = "removed before it was seen to finish, its outcome is unknown"
+
+// JobResult is the outcome of a single run of a job.
+type JobResult struct {
+	// Job is the name of the job.
+	Job string
+	// Name is the name of the pod or container that ran it.
+	Name      string
+	Succeeded bool
+	ExitCode  int32
+	// Reason explains a failure, if the backend reports one.
+	Reason string
+}
+
+func (r *JobResult) String() string {
+	result := "succeeded"
+	if !r.Succeeded {
+		result = "failed"
+	}
+	s := fmt.Sprintf("%s/%s: %s (exit code %d)", r.Job, r.Name, result, r.ExitCode)
+	if len(r.Reason) > 0 {
+		s += ": " + r.Reason
+	}
+	return s
+}
+
+// poll calls done until it returns true, or the timeout expires.
+func poll(timeout time.Duration, done func() (bool, error)) error {
+	var deadline <-chan time.Time
+	if timeout > 0 {
+		deadline = time.After(timeout)
+	}
+	for {
+		finished, err := done()
+		if err != nil || finished {
+			return err
+		}
+		select {
+		case <-deadline:
+			return fmt.Errorf("timed out after %v", timeout)
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// failedJobs returns an error naming the jobs that failed, or nil if all of
+// them succeeded.
+func failedJobs(failed []string) error {
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("failed jobs: %s", strings.Join(failed, ", "))
+}
+
+// pollJobs waits for every run of the jobs of a service, for backends that
+// execute each run separately. check returns nil while a run is going on.
+func pollJobs(svc *models.Service, timeout time.Duration, check func(job *models.JobSpecification, name string) (*JobResult, error)) ([]*JobResult, error) {
+	done := map[string]*JobResult{}
+	err := poll(timeout, func() (bool, error) {
+		finished := true
+		for _, job := range svc.Jobs {
+			for ix := int32(0); ix < jobCompletions(job); ix++ {
+				name := jobInstanceName(job, ix)
+				if done[name] != nil {
+					continue
+				}
+				result, err := check(job, name)
+				if err != nil {
+					return false, err
+				}
+				if result == nil {
+					finished = false
+					continue
+				}
+				done[name] = result
+			}
+		}
+		return finished, nil
+	})
+
+	results := []*JobResult{}
+	failed := []string{}
+	for _, job := range svc.Jobs {
+		for ix := int32(0); ix < jobCompletions(job); ix++ {
+			if result := done[jobInstanceName(job, ix)]; result != nil {
+				results = append(results, result)
+				if !result.Succeeded && len(result.Reason) > 0 {
+					failed = append(failed, fmt.Sprintf("%s (%s)", result.Name, result.Reason))
+				} else if !result.Succeeded {
+					failed = append(failed, result.Name)
+				}
+			}
+		}
+	}
+	if err != nil {
+		return results, err
+	}
+	return results, failedJobs(failed)
+}
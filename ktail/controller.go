@@ -3,6 +3,7 @@ package ktail
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	"k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -25,13 +26,27 @@ type Callbacks struct {
 	OnError ContainerErrorFunc
 }
 
+// TailOptions change which lines of the containers are tailed.
+type TailOptions struct {
+	// Since skips the lines written before it, unless it is zero.
+	Since time.Time
+	// FromBeginning reads the whole log of the containers that are already
+	// running, instead of only the lines they write from now on.
+	FromBeginning bool
+	// NoFollow reads the lines written so far and stops, instead of
+	// waiting for new lines and new containers.
+	NoFollow bool
+}
+
 type Controller struct {
 	sync.Mutex
 	clientset     *kubernetes.Clientset
 	tailers       map[string]*ContainerTailer
+	running       sync.WaitGroup
 	namespace     string
 	labelSelector labels.Selector
 	callbacks     Callbacks
+	options       TailOptions
 }
 
 func NewController(
@@ -48,13 +63,25 @@ func NewController(
 	}
 }
 
-func (ctl *Controller) Run() {
+// SetOptions changes which lines are tailed. It must be called before Run.
+func (ctl *Controller) SetOptions(options TailOptions) {
+	ctl.options = options
+}
+
+func (ctl *Controller) Run() error {
+	return ctl.RunUntil(make(chan struct{}))
+}
+
+// RunUntil tails the matching containers until stopCh is closed or, with
+// NoFollow, until the lines written so far have been read. No callback is
+// called after it returns.
+func (ctl *Controller) RunUntil(stopCh <-chan struct{}) error {
 	podListWatcher := cache.NewListWatchFromClient(
-		ctl.clientset.Core().RESTClient(), "pods", ctl.namespace, fields.Everything())
+		ctl.clientset.CoreV1().RESTClient(), "pods", ctl.namespace, fields.Everything())
 
 	obj, err := podListWatcher.List(metav1.ListOptions{})
 	if err != nil {
-		panic(err)
+		return err
 	}
 	if podList, ok := obj.(*v1.PodList); ok {
 		for _, pod := range podList.Items {
@@ -62,23 +89,44 @@ func (ctl *Controller) Run() {
 		}
 	}
 
-	_, informer := cache.NewIndexerInformer(podListWatcher, &v1.Pod{}, 0, cache.ResourceEventHandlerFuncs{
-		AddFunc: func(obj interface{}) {
-			if pod, ok := obj.(*v1.Pod); ok {
-				ctl.onAdd(pod)
-			}
-		},
-		UpdateFunc: func(old interface{}, new interface{}) {},
-		DeleteFunc: func(obj interface{}) {
-			if pod, ok := obj.(*v1.Pod); ok {
-				ctl.onDelete(pod)
-			}
-		},
-	}, cache.Indexers{})
-
-	stopCh := make(chan struct{}, 1)
-	go informer.Run(stopCh)
-	<-stopCh
+	if ctl.options.NoFollow {
+		done := make(chan struct{})
+		go func() {
+			ctl.running.Wait()
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-stopCh:
+		}
+	} else {
+		_, informer := cache.NewIndexerInformer(podListWatcher, &v1.Pod{}, 0, cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				if pod, ok := obj.(*v1.Pod); ok {
+					ctl.onAdd(pod)
+				}
+			},
+			UpdateFunc: func(old interface{}, new interface{}) {},
+			DeleteFunc: func(obj interface{}) {
+				if pod, ok := obj.(*v1.Pod); ok {
+					ctl.onDelete(pod)
+				}
+			},
+		}, cache.Indexers{})
+
+		go informer.Run(stopCh)
+		<-stopCh
+	}
+
+	ctl.Lock()
+	defer ctl.Unlock()
+	for key, tailer := range ctl.tailers {
+		delete(ctl.tailers, key)
+		tailer.Stop()
+	}
+	// stopped tailers close their streams, so no event comes after this
+	ctl.running.Wait()
+	return nil
 }
 
 func (ctl *Controller) onInitialAdd(pod *v1.Pod) {
@@ -121,8 +169,10 @@ func (ctl *Controller) addContainer(pod *v1.Pod, container *v1.Container, discov
 	targetPod, targetContainer := *pod, *container // Copy to avoid mutation
 
 	tailer := NewContainerTailer(ctl.clientset, targetPod, targetContainer, ctl.callbacks.OnEvent,
-		!discoveryPhase)
+		!discoveryPhase, ctl.options)
+	ctl.running.Add(1)
 	go func() {
+		defer ctl.running.Done()
 		if err := tailer.Run(); err != nil {
 			ctl.callbacks.OnError(&targetPod, &targetContainer, err)
 		}
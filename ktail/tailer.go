@@ -5,6 +5,7 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/jpillora/backoff"
@@ -28,31 +29,48 @@ func NewContainerTailer(
 	pod v1.Pod,
 	container v1.Container,
 	eventFunc LogEventFunc,
-	newlyCreatedPod bool) *ContainerTailer {
+	newlyCreatedPod bool,
+	options TailOptions) *ContainerTailer {
 	return &ContainerTailer{
 		clientset:     clientset,
 		pod:           pod,
 		container:     container,
 		eventFunc:     eventFunc,
-		fromBeginning: newlyCreatedPod,
+		fromBeginning: newlyCreatedPod || options.FromBeginning,
+		options:       options,
 	}
 }
 
 type ContainerTailer struct {
+	sync.Mutex
 	clientset     *kubernetes.Clientset
 	pod           v1.Pod
 	container     v1.Container
 	stop          bool
+	stream        io.ReadCloser
 	eventFunc     LogEventFunc
 	fromBeginning bool
+	options       TailOptions
 }
 
+// Stop ends the tailing, closing the stream that is being read.
 func (ct *ContainerTailer) Stop() {
+	ct.Lock()
+	defer ct.Unlock()
 	ct.stop = true
+	if ct.stream != nil {
+		_ = ct.stream.Close()
+	}
+}
+
+func (ct *ContainerTailer) stopped() bool {
+	ct.Lock()
+	defer ct.Unlock()
+	return ct.stop
 }
 
 func (ct *ContainerTailer) Run() error {
-	for !ct.stop {
+	for !ct.stopped() {
 		stream, err := ct.getStream()
 		if err != nil {
 			return err
@@ -60,9 +78,24 @@ func (ct *ContainerTailer) Run() error {
 		if stream == nil {
 			break
 		}
+		ct.Lock()
+		if ct.stop {
+			ct.Unlock()
+			_ = stream.Close()
+			break
+		}
+		ct.stream = stream
+		ct.Unlock()
 		if err := ct.runStream(stream); err != nil {
+			if ct.stopped() {
+				// reading the stream fails once Stop closes it
+				return nil
+			}
 			return err
 		}
+		if ct.options.NoFollow {
+			break
+		}
 	}
 	return nil
 }
@@ -115,7 +148,11 @@ func (ct *ContainerTailer) receiveLine(s string) {
 
 func (ct *ContainerTailer) getStream() (io.ReadCloser, error) {
 	var sinceTime *metav1.Time
-	if !ct.fromBeginning {
+	if !ct.options.Since.IsZero() {
+		sinceTime = &metav1.Time{
+			Time: ct.options.Since,
+		}
+	} else if !ct.fromBeginning {
 		t := metav1.Time{
 			Time: time.Now().Add(-1 * time.Second),
 		}
@@ -124,14 +161,15 @@ func (ct *ContainerTailer) getStream() (io.ReadCloser, error) {
 
 	boff := &backoff.Backoff{}
 	for {
-		stream, err := ct.clientset.Core().Pods(ct.pod.Namespace).GetLogs(ct.pod.Name, &v1.PodLogOptions{
+		stream, err := ct.clientset.CoreV1().Pods(ct.pod.Namespace).GetLogs(ct.pod.Name, &v1.PodLogOptions{
 			Container:  ct.container.Name,
-			Follow:     true,
+			Follow:     !ct.options.NoFollow,
 			Timestamps: true,
 			SinceTime:  sinceTime,
 		}).Stream()
 		if err == nil {
 			ct.fromBeginning = false // We have now started
+			ct.options.Since = time.Time{}
 			return stream, nil
 		}
 		if status, ok := err.(errors.APIStatus); ok {
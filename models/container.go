@@ -6,6 +6,8 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"encoding/json"
+
 	strfmt "github.com/go-openapi/strfmt"
 
 	"github.com/go-openapi/errors"
@@ -23,6 +25,10 @@ type Container struct {
 	// image
 	// Required: true
 	Image *string `json:"image"`
+
+	// image pull policy
+	// Enum: [Always,IfNotPresent,Never]
+	ImagePullPolicy string `json:"imagePullPolicy,omitempty"`
 }
 
 // Validate validates this container
@@ -34,6 +40,11 @@ func (m *Container) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateImagePullPolicy(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -49,6 +60,51 @@ func (m *Container) validateImage(formats strfmt.Registry) error {
 	return nil
 }
 
+var containerTypeImagePullPolicyPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["Always","IfNotPresent","Never"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		containerTypeImagePullPolicyPropEnum = append(containerTypeImagePullPolicyPropEnum, v)
+	}
+}
+
+const (
+	// ContainerImagePullPolicyAlways captures enum value "Always"
+	ContainerImagePullPolicyAlways string = "Always"
+
+	// ContainerImagePullPolicyIfNotPresent captures enum value "IfNotPresent"
+	ContainerImagePullPolicyIfNotPresent string = "IfNotPresent"
+
+	// ContainerImagePullPolicyNever captures enum value "Never"
+	ContainerImagePullPolicyNever string = "Never"
+)
+
+// prop value enum
+func (m *Container) validateImagePullPolicyEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, containerTypeImagePullPolicyPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Container) validateImagePullPolicy(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ImagePullPolicy) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateImagePullPolicyEnum("imagePullPolicy", "body", m.ImagePullPolicy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Container) MarshalBinary() ([]byte, error) {
 	if m == nil {
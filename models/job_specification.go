@@ -6,6 +6,8 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"encoding/json"
+
 	strfmt "github.com/go-openapi/strfmt"
 
 	"github.com/go-openapi/errors"
@@ -17,35 +19,131 @@ import (
 // swagger:model jobSpecification
 type JobSpecification struct {
 
+	// active deadline seconds
+	// Minimum: 1
+	ActiveDeadlineSeconds int64 `json:"activeDeadlineSeconds,omitempty"`
+
+	// backoff limit
+	// Minimum: 0
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+
 	// containers
 	Containers JobSpecificationContainers `json:"containers"`
 
+	// depends
+	Depends string `json:"depends,omitempty"`
+
+	// indexed
+	Indexed bool `json:"indexed,omitempty"`
+
 	// name
 	// Required: true
 	Name *string `json:"name"`
 
+	// parallelism
+	// Minimum: 1
+	Parallelism int32 `json:"parallelism,omitempty"`
+
+	// placement
+	Placement *PlacementSpecification `json:"placement,omitempty"`
+
 	// replicas
 	Replicas int32 `json:"replicas,omitempty"`
 
+	// restart policy
+	// Enum: [OnFailure,Never]
+	RestartPolicy string `json:"restartPolicy,omitempty"`
+
 	// schedule
 	Schedule string `json:"schedule,omitempty"`
+
+	// security context
+	SecurityContext *SecurityContext `json:"securityContext,omitempty"`
+
+	// service account name
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// ttl seconds after finished
+	// Minimum: 0
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
 }
 
 // Validate validates this job specification
 func (m *JobSpecification) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateActiveDeadlineSeconds(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if err := m.validateBackoffLimit(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		// prop
 		res = append(res, err)
 	}
 
+	if err := m.validateParallelism(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if err := m.validatePlacement(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if err := m.validateRestartPolicy(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if err := m.validateSecurityContext(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if err := m.validateTTLSecondsAfterFinished(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *JobSpecification) validateActiveDeadlineSeconds(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ActiveDeadlineSeconds) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("activeDeadlineSeconds", "body", int64(m.ActiveDeadlineSeconds), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *JobSpecification) validateBackoffLimit(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.BackoffLimit) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("backoffLimit", "body", int64(*m.BackoffLimit), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *JobSpecification) validateName(formats strfmt.Registry) error {
 
 	if err := validate.Required("name", "body", m.Name); err != nil {
@@ -55,6 +153,112 @@ func (m *JobSpecification) validateName(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *JobSpecification) validateParallelism(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Parallelism) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("parallelism", "body", int64(m.Parallelism), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *JobSpecification) validatePlacement(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Placement) { // not required
+		return nil
+	}
+
+	if m.Placement != nil {
+
+		if err := m.Placement.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("placement")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var jobSpecificationTypeRestartPolicyPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["OnFailure","Never"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		jobSpecificationTypeRestartPolicyPropEnum = append(jobSpecificationTypeRestartPolicyPropEnum, v)
+	}
+}
+
+const (
+	// JobSpecificationRestartPolicyOnFailure captures enum value "OnFailure"
+	JobSpecificationRestartPolicyOnFailure string = "OnFailure"
+
+	// JobSpecificationRestartPolicyNever captures enum value "Never"
+	JobSpecificationRestartPolicyNever string = "Never"
+)
+
+// prop value enum
+func (m *JobSpecification) validateRestartPolicyEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, jobSpecificationTypeRestartPolicyPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *JobSpecification) validateRestartPolicy(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.RestartPolicy) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateRestartPolicyEnum("restartPolicy", "body", m.RestartPolicy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *JobSpecification) validateSecurityContext(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SecurityContext) { // not required
+		return nil
+	}
+
+	if m.SecurityContext != nil {
+
+		if err := m.SecurityContext.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("securityContext")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *JobSpecification) validateTTLSecondsAfterFinished(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.TTLSecondsAfterFinished) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("ttlSecondsAfterFinished", "body", int64(*m.TTLSecondsAfterFinished), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *JobSpecification) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -17,12 +17,24 @@ import (
 // swagger:model serveSpecification
 type ServeSpecification struct {
 
+	// hostnames
+	Hostnames []string `json:"hostnames"`
+
+	// ingress class
+	IngressClass string `json:"ingressClass,omitempty"`
+
 	// name
 	// Required: true
 	Name *string `json:"name"`
 
+	// paths
+	Paths ServeSpecificationPaths `json:"paths"`
+
 	// public
 	Public bool `json:"public,omitempty"`
+
+	// tls secret
+	TLSSecret string `json:"tlsSecret,omitempty"`
 }
 
 // Validate validates this serve specification
@@ -17,6 +17,9 @@ import (
 // swagger:model service
 type Service struct {
 
+	// deployed backend
+	DeployedBackend string `json:"deployedBackend,omitempty"`
+
 	// guid
 	// Required: true
 	GUID *int64 `json:"guid"`
@@ -24,14 +27,26 @@ type Service struct {
 	// jobs
 	Jobs ServiceJobs `json:"jobs"`
 
+	// labels
+	Labels map[string]string `json:"labels,omitempty"`
+
 	// name
 	// Required: true
 	// Min Length: 1
 	Name *string `json:"name"`
 
+	// registries
+	Registries ServiceRegistries `json:"registries"`
+
+	// resource version
+	ResourceVersion int64 `json:"resourceVersion,omitempty"`
+
 	// serve
 	Serve *ServeSpecification `json:"serve,omitempty"`
 
+	// serves
+	Serves ServiceServes `json:"serves"`
+
 	// services
 	Services ServiceServices `json:"services"`
 }
@@ -17,6 +17,12 @@ import (
 // swagger:model servicePort
 type ServicePort struct {
 
+	// direct
+	Direct bool `json:"direct,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
 	// number
 	// Required: true
 	Number *int32 `json:"number"`
@@ -23,10 +23,19 @@ type ServiceSpecification struct {
 	// depends
 	Depends string `json:"depends,omitempty"`
 
+	// disable network policy
+	DisableNetworkPolicy bool `json:"disableNetworkPolicy,omitempty"`
+
+	// disruption budget
+	DisruptionBudget *DisruptionBudget `json:"disruptionBudget,omitempty"`
+
 	// name
 	// Required: true
 	Name *string `json:"name"`
 
+	// placement
+	Placement *PlacementSpecification `json:"placement,omitempty"`
+
 	// ports
 	Ports ServiceSpecificationPorts `json:"ports"`
 
@@ -36,6 +45,12 @@ type ServiceSpecification struct {
 	// replicas
 	Replicas int32 `json:"replicas,omitempty"`
 
+	// security context
+	SecurityContext *SecurityContext `json:"securityContext,omitempty"`
+
+	// service account name
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
 	// shard spec
 	ShardSpec *ShardSpecification `json:"shardSpec,omitempty"`
 }
@@ -44,11 +59,26 @@ type ServiceSpecification struct {
 func (m *ServiceSpecification) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateDisruptionBudget(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		// prop
 		res = append(res, err)
 	}
 
+	if err := m.validatePlacement(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if err := m.validateSecurityContext(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
 	if err := m.validateShardSpec(formats); err != nil {
 		// prop
 		res = append(res, err)
@@ -60,6 +90,25 @@ func (m *ServiceSpecification) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ServiceSpecification) validateDisruptionBudget(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.DisruptionBudget) { // not required
+		return nil
+	}
+
+	if m.DisruptionBudget != nil {
+
+		if err := m.DisruptionBudget.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("disruptionBudget")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ServiceSpecification) validateName(formats strfmt.Registry) error {
 
 	if err := validate.Required("name", "body", m.Name); err != nil {
@@ -69,6 +118,44 @@ func (m *ServiceSpecification) validateName(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ServiceSpecification) validatePlacement(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Placement) { // not required
+		return nil
+	}
+
+	if m.Placement != nil {
+
+		if err := m.Placement.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("placement")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServiceSpecification) validateSecurityContext(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SecurityContext) { // not required
+		return nil
+	}
+
+	if m.SecurityContext != nil {
+
+		if err := m.SecurityContext.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("securityContext")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ServiceSpecification) validateShardSpec(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.ShardSpec) { // not required
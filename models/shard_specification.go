@@ -19,9 +19,18 @@ type ShardSpecification struct {
 	// field path
 	FieldPath string `json:"fieldPath,omitempty"`
 
+	// header
+	Header string `json:"header,omitempty"`
+
+	// sharder
+	Sharder *SharderSpecification `json:"sharder,omitempty"`
+
 	// shards
 	Shards int32 `json:"shards,omitempty"`
 
+	// spread across zones
+	SpreadAcrossZones bool `json:"spreadAcrossZones,omitempty"`
+
 	// url pattern
 	URLPattern string `json:"urlPattern,omitempty"`
 }
@@ -30,12 +39,36 @@ type ShardSpecification struct {
 func (m *ShardSpecification) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateSharder(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *ShardSpecification) validateSharder(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Sharder) { // not required
+		return nil
+	}
+
+	if m.Sharder != nil {
+
+		if err := m.Sharder.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("sharder")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *ShardSpecification) MarshalBinary() ([]byte, error) {
 	if m == nil {
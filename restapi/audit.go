@@ -0,0 +1,154 @@
+package restapi
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	runtime "github.com/go-openapi/runtime"
+	middleware "github.com/go-openapi/runtime/middleware"
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+	"github.com/metaparticle-io/metaparticle-ast/restapi/operations/services"
+)
+
+// newAuditEntry starts the audit entry of a request, the handler adds what
+// it changed.
+func newAuditEntry(r *http.Request, operation string, name string) *models.AuditEntry {
+	return &models.AuditEntry{
+		Timestamp:  strfmt.DateTime(time.Now()),
+		User:       User(r),
+		RemoteAddr: r.RemoteAddr,
+		Service:    swag.String(name),
+		Operation:  swag.String(operation),
+	}
+}
+
+// specDigest returns the sha256 digest of the spec of a service, leaving out
+// the fields set by the server, or nothing when there is no service.
+func specDigest(svc *models.Service) string {
+	if svc == nil {
+		return ""
+	}
+	spec := *svc
+	spec.ResourceVersion = 0
+	spec.DeployedBackend = ""
+	// a service always marshals
+	data, _ := json.Marshal(&spec)
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// auditRecorder holds a response until it is audited.
+type auditRecorder struct {
+	header http.Header
+	code   int
+	body   bytes.Buffer
+}
+
+func (a *auditRecorder) Header() http.Header {
+	return a.header
+}
+
+func (a *auditRecorder) WriteHeader(code int) {
+	a.code = code
+}
+
+func (a *auditRecorder) Write(data []byte) (int, error) {
+	return a.body.Write(data)
+}
+
+// audited runs handle between two entries of the audit log. A pending entry
+// is appended first, and the request is refused with 503 when it can't be,
+// so nothing changes that the log doesn't know about. The entry of the
+// outcome is appended before the response is sent, and a failure to append
+// it turns the response into a 500: the change may have been made, but the
+// caller doesn't see it succeed. Failures to append are counted by
+// metaparticle_audit_failures_total.
+//
+// A request failed when its status isn't 2xx, or when the handler says so,
+// and the message of a failure is taken from its error.
+func (i *Impl) audited(entry *models.AuditEntry, handle func() middleware.Responder) middleware.Responder {
+	intent := *entry
+	intent.Pending = true
+	intent.Code = swag.Int32(0)
+	intent.Succeeded = swag.Bool(false)
+	if err := i.appendAudit(&intent); err != nil {
+		return auditFailure(http.StatusServiceUnavailable, "nothing was changed, the audit log can't be written: "+err.Error())
+	}
+	entry.IntentID = *intent.ID
+	responder := handle()
+
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, producer runtime.Producer) {
+		recorder := &auditRecorder{header: http.Header{}, code: http.StatusOK}
+		responder.WriteResponse(recorder, producer)
+
+		entry.Code = swag.Int32(int32(recorder.code))
+		if entry.Succeeded == nil || recorder.code >= 300 {
+			entry.Succeeded = swag.Bool(recorder.code < 300)
+		}
+		if recorder.code >= 300 {
+			payload := &models.Error{}
+			if err := json.Unmarshal(recorder.body.Bytes(), payload); err == nil && payload.Message != nil {
+				entry.Message = *payload.Message
+			}
+		}
+		if err := i.appendAudit(entry); err != nil {
+			message := fmt.Sprintf("the outcome (%d) couldn't be written to the audit log: %v", recorder.code, err)
+			auditFailure(http.StatusInternalServerError, message).WriteResponse(rw, producer)
+			return
+		}
+
+		for key, values := range recorder.header {
+			rw.Header()[key] = values
+		}
+		rw.WriteHeader(recorder.code)
+		rw.Write(recorder.body.Bytes())
+	})
+}
+
+// appendAudit appends an entry to the audit log, counting and logging the
+// failures.
+func (i *Impl) appendAudit(entry *models.AuditEntry) error {
+	err := i.audit.Append(entry)
+	if err != nil {
+		auditFailuresTotal.Inc()
+		log.Printf("Failed to append to the audit log: %v", err)
+	}
+	return err
+}
+
+// auditFailure responds with an error when the audit log can't be written.
+func auditFailure(code int, message string) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, producer runtime.Producer) {
+		writeError(rw, apiError(code, message))
+	})
+}
+
+// HandleListAuditEntries implements the ListAuditEntriesHandler interface.
+// Only the entries of the services the caller administers are listed.
+func (i *Impl) HandleListAuditEntries(params services.ListAuditEntriesParams) middleware.Responder {
+	entries, err := i.audit.Entries(func(entry *models.AuditEntry) bool {
+		timestamp := time.Time(entry.Timestamp)
+		if params.Since != nil && timestamp.Before(time.Time(*params.Since)) {
+			return false
+		}
+		if params.Until != nil && !timestamp.Before(time.Time(*params.Until)) {
+			return false
+		}
+		if params.Service != nil && *entry.Service != *params.Service {
+			return false
+		}
+		return i.auth.Allowed(params.HTTPRequest, RoleAdmin, *entry.Service)
+	})
+	if err != nil {
+		return services.NewListAuditEntriesDefault(http.StatusInternalServerError).WithPayload(apiError(http.StatusInternalServerError, err.Error()))
+	}
+	return services.NewListAuditEntriesOK().WithPayload(entries)
+}
@@ -0,0 +1,159 @@
+package restapi
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/go-openapi/swag"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+)
+
+// AuditLog keeps every mutating request made to the server. Entries are only
+// ever appended, never changed or removed.
+type AuditLog interface {
+	// Append gives an entry the next id and adds it to the log.
+	Append(entry *models.AuditEntry) error
+	// Entries returns the entries that match accepts, oldest first.
+	Entries(match func(*models.AuditEntry) bool) ([]*models.AuditEntry, error)
+}
+
+// AuditOptions are the command line flags that select the audit log.
+type AuditOptions struct {
+	AuditLog string `long:"audit-log" description:"the file to append the audit log to, it's only kept in memory when empty"`
+}
+
+// NewAuditLog creates the audit log selected by opts.
+func NewAuditLog(opts *AuditOptions) (AuditLog, error) {
+	if len(opts.AuditLog) == 0 {
+		return NewMemoryAuditLog(), nil
+	}
+	return NewFileAuditLog(opts.AuditLog)
+}
+
+type memoryAuditLog struct {
+	sync.Mutex
+	entries []*models.AuditEntry
+}
+
+// NewMemoryAuditLog creates an audit log that is lost when the server exits.
+func NewMemoryAuditLog() AuditLog {
+	return &memoryAuditLog{}
+}
+
+func (m *memoryAuditLog) Append(entry *models.AuditEntry) error {
+	m.Lock()
+	defer m.Unlock()
+	entry.ID = swag.Int64(int64(len(m.entries)) + 1)
+	m.entries = append(m.entries, entry)
+	return nil
+}
+
+func (m *memoryAuditLog) Entries(match func(*models.AuditEntry) bool) ([]*models.AuditEntry, error) {
+	m.Lock()
+	defer m.Unlock()
+	result := []*models.AuditEntry{}
+	for _, entry := range m.entries {
+		if match(entry) {
+			result = append(result, entry)
+		}
+	}
+	return result, nil
+}
+
+// fileAuditLog appends every entry to a file as a line of JSON and syncs it
+// before the request is answered.
+type fileAuditLog struct {
+	sync.Mutex
+	name string
+	file *os.File
+	last int64
+}
+
+// NewFileAuditLog opens the audit log in the named file, creating it if needed.
+func NewFileAuditLog(name string) (AuditLog, error) {
+	file, err := os.OpenFile(name, os.O_RDWR|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	f := &fileAuditLog{name: name, file: file}
+	if err := f.open(); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+// open continues the ids of the entries in the file. A crash can leave a
+// partly written entry at its end, which is ended so that the next entry
+// starts on a line of its own.
+func (f *fileAuditLog) open() error {
+	end, err := f.file.Seek(0, io.SeekEnd)
+	if err != nil || end == 0 {
+		return err
+	}
+	last := make([]byte, 1)
+	if _, err := f.file.ReadAt(last, end-1); err != nil {
+		return err
+	}
+	if last[0] != '\n' {
+		if _, err := f.file.Write([]byte("\n")); err != nil {
+			return err
+		}
+	}
+	return f.read(func(entry *models.AuditEntry) {
+		f.last = *entry.ID
+	})
+}
+
+// read calls handle with every complete entry in the file.
+func (f *fileAuditLog) read(handle func(*models.AuditEntry)) error {
+	file, err := os.Open(f.name)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		entry := &models.AuditEntry{}
+		if err := json.Unmarshal(scanner.Bytes(), entry); err != nil || entry.ID == nil {
+			// a partly written entry
+			continue
+		}
+		handle(entry)
+	}
+	return scanner.Err()
+}
+
+func (f *fileAuditLog) Append(entry *models.AuditEntry) error {
+	f.Lock()
+	defer f.Unlock()
+	entry.ID = swag.Int64(f.last + 1)
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if _, err := f.file.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	if err := f.file.Sync(); err != nil {
+		return err
+	}
+	f.last = *entry.ID
+	return nil
+}
+
+func (f *fileAuditLog) Entries(match func(*models.AuditEntry) bool) ([]*models.AuditEntry, error) {
+	f.Lock()
+	defer f.Unlock()
+	result := []*models.AuditEntry{}
+	err := f.read(func(entry *models.AuditEntry) {
+		if match(entry) {
+			result = append(result, entry)
+		}
+	})
+	return result, err
+}
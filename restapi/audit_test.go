@@ -0,0 +1,180 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+	"github.com/metaparticle-io/metaparticle-ast/restapi/operations/services"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+// failingAuditLog fails every Append after the first accept.
+type failingAuditLog struct {
+	AuditLog
+	accept int
+}
+
+func (f *failingAuditLog) Append(entry *models.AuditEntry) error {
+	if f.accept == 0 {
+		return fmt.Errorf("disk full")
+	}
+	f.accept--
+	return f.AuditLog.Append(entry)
+}
+
+func TestAudited(t *testing.T) {
+	tests := []struct {
+		name    string
+		accept  int
+		code    int
+		stored  bool
+		entries int
+	}{
+		{name: "logged", accept: 2, code: http.StatusOK, stored: true, entries: 2},
+		{name: "pending entry fails", accept: 0, code: http.StatusServiceUnavailable, stored: false, entries: 0},
+		{name: "outcome fails", accept: 1, code: http.StatusInternalServerError, stored: true, entries: 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			log := &failingAuditLog{AuditLog: NewMemoryAuditLog(), accept: test.accept}
+			impl, err := NewImpl(NewMemoryStore(), nil, log, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			failures := testutil.ToFloat64(auditFailuresTotal)
+
+			rw := put(t, impl, "web", `{"name": "web"}`, "")
+			if rw.Code != test.code {
+				t.Fatalf("expected %d, got %d: %s", test.code, rw.Code, rw.Body.String())
+			}
+			if stored, _ := impl.store.Get("web"); (stored != nil) != test.stored {
+				t.Errorf("expected stored to be %v, got %v", test.stored, stored)
+			}
+			entries, _ := log.Entries(func(*models.AuditEntry) bool { return true })
+			if len(entries) != test.entries {
+				t.Fatalf("expected %d entries, got %d", test.entries, len(entries))
+			}
+			if test.code == http.StatusOK {
+				if failures != testutil.ToFloat64(auditFailuresTotal) {
+					t.Errorf("expected no audit failures to be counted")
+				}
+				pending, outcome := entries[0], entries[1]
+				if !pending.Pending || *pending.Code != 0 || *pending.Succeeded {
+					t.Errorf("unexpected pending entry %#v", pending)
+				}
+				if outcome.Pending || outcome.IntentID != *pending.ID || *outcome.Code != http.StatusOK || !*outcome.Succeeded {
+					t.Errorf("unexpected outcome entry %#v", outcome)
+				}
+				if len(rw.Header().Get("ETag")) == 0 {
+					t.Errorf("expected the headers of the response, got %v", rw.Header())
+				}
+				return
+			}
+			if failures+1 != testutil.ToFloat64(auditFailuresTotal) {
+				t.Errorf("expected the audit failure to be counted")
+			}
+			payload := &models.Error{}
+			if err := json.Unmarshal(rw.Body.Bytes(), payload); err != nil || payload.Code != int64(test.code) {
+				t.Errorf("expected an error, got %s", rw.Body.String())
+			}
+			if len(rw.Header().Get("ETag")) > 0 {
+				t.Errorf("expected the headers of the failed response to be dropped, got %v", rw.Header())
+			}
+		})
+	}
+}
+
+func TestAuditedFailures(t *testing.T) {
+	impl := newTestImpl(t, nil)
+	rw := respond(impl.HandleDeploy(services.DeployServiceParams{
+		HTTPRequest: httptest.NewRequest(http.MethodPost, "/services/missing/deploy", nil),
+		Name:        "missing",
+		DryRun:      swag.Bool(true),
+	}))
+	if rw.Code != http.StatusNotFound {
+		t.Fatalf("expected %d, got %d: %s", http.StatusNotFound, rw.Code, rw.Body.String())
+	}
+	entries, _ := impl.audit.Entries(func(entry *models.AuditEntry) bool { return !entry.Pending })
+	if len(entries) != 1 {
+		t.Fatalf("expected an outcome, got %v", entries)
+	}
+	entry := entries[0]
+	if *entry.Operation != models.AuditEntryOperationDeploy || *entry.Code != http.StatusNotFound || *entry.Succeeded || len(entry.Message) == 0 {
+		t.Errorf("unexpected entry %#v", entry)
+	}
+}
+
+func TestAuditLogs(t *testing.T) {
+	logs := map[string]func(t *testing.T) AuditLog{
+		"memory": func(t *testing.T) AuditLog {
+			return NewMemoryAuditLog()
+		},
+		"file": func(t *testing.T) AuditLog {
+			log, err := NewFileAuditLog(path.Join(t.TempDir(), "audit.log"))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			return log
+		},
+	}
+	for name, newLog := range logs {
+		t.Run(name, func(t *testing.T) {
+			log := newLog(t)
+			for _, service := range []string{"web", "api", "web"} {
+				entry := &models.AuditEntry{Service: swag.String(service), Operation: swag.String(models.AuditEntryOperationUpdate)}
+				if err := log.Append(entry); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			entries, err := log.Entries(func(entry *models.AuditEntry) bool {
+				return *entry.Service == "web"
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(entries) != 2 || *entries[0].ID != 1 || *entries[1].ID != 3 {
+				t.Errorf("expected entries 1 and 3, got %v", entries)
+			}
+		})
+	}
+}
+
+func TestFileAuditLogReopen(t *testing.T) {
+	name := path.Join(t.TempDir(), "audit.log")
+	log, err := NewFileAuditLog(name)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := log.Append(&models.AuditEntry{Service: swag.String("web")}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// a crash in the middle of the next entry
+	file, err := os.OpenFile(name, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	file.Write([]byte(`{"id": 2, "serv`))
+	file.Close()
+
+	log, err = NewFileAuditLog(name)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := log.Append(&models.AuditEntry{Service: swag.String("api")}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	entries, err := log.Entries(func(*models.AuditEntry) bool { return true })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 2 || *entries[1].ID != 2 || *entries[1].Service != "api" {
+		t.Errorf("expected the ids to continue after the partial entry, got %v", entries)
+	}
+}
@@ -0,0 +1,217 @@
+package restapi
+
+import (
+	"bufio"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+
+	runtime "github.com/go-openapi/runtime"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+)
+
+// AuthOptions are the command line flags that turn on authentication.
+type AuthOptions struct {
+	Policy string `long:"auth-policy" description:"a file of 'user role [pattern...]' lines granting roles on services, turns on authentication"`
+	Tokens string `long:"auth-tokens" description:"a file of 'token,user' lines, the bearer tokens users authenticate with"`
+}
+
+// Role is what a user may do with a service. Every role can do what the
+// roles before it can.
+type Role int
+
+const (
+	// RoleReader reads services, their status, logs and revisions.
+	RoleReader Role = iota + 1
+	// RoleDeployer also updates, deploys, undeploys and rolls back services.
+	RoleDeployer
+	// RoleAdmin also deletes services.
+	RoleAdmin
+)
+
+var roleNames = map[string]Role{
+	"reader":   RoleReader,
+	"deployer": RoleDeployer,
+	"admin":    RoleAdmin,
+}
+
+func (r Role) String() string {
+	for name, role := range roleNames {
+		if role == r {
+			return name
+		}
+	}
+	return fmt.Sprintf("Role(%d)", int(r))
+}
+
+// grant gives a user a role on the services matching any of the patterns.
+type grant struct {
+	role     Role
+	patterns []string
+}
+
+// Auth authenticates requests with bearer tokens or client certificates,
+// whose common name is the user, and authorizes them with the roles granted
+// by the policy. A nil Auth lets everyone do everything.
+type Auth struct {
+	tokens map[string]string
+	grants map[string][]grant
+}
+
+type userKey struct{}
+
+// NewAuth reads the files named by opts. It returns nil when there is no
+// policy, which leaves the server open.
+func NewAuth(opts *AuthOptions) (*Auth, error) {
+	if len(opts.Policy) == 0 {
+		if len(opts.Tokens) > 0 {
+			return nil, fmt.Errorf("--auth-tokens needs an --auth-policy")
+		}
+		return nil, nil
+	}
+	a := &Auth{
+		tokens: map[string]string{},
+		grants: map[string][]grant{},
+	}
+	err := readLines(opts.Policy, func(line string) error {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return fmt.Errorf("expected 'user role [pattern...]', found %q", line)
+		}
+		role, found := roleNames[fields[1]]
+		if !found {
+			return fmt.Errorf("unknown role %q, expected reader, deployer or admin", fields[1])
+		}
+		patterns := fields[2:]
+		if len(patterns) == 0 {
+			patterns = []string{"*"}
+		}
+		for _, pattern := range patterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("bad pattern %q: %v", pattern, err)
+			}
+		}
+		a.grants[fields[0]] = append(a.grants[fields[0]], grant{role, patterns})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(opts.Tokens) > 0 {
+		err := readLines(opts.Tokens, func(line string) error {
+			fields := strings.Split(line, ",")
+			if len(fields) != 2 || len(fields[0]) == 0 || len(fields[1]) == 0 {
+				return fmt.Errorf("expected 'token,user'")
+			}
+			a.tokens[strings.TrimSpace(fields[0])] = strings.TrimSpace(fields[1])
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return a, nil
+}
+
+// readLines calls parse with every line of a file that isn't blank or a
+// comment.
+func readLines(file string, parse func(line string) error) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for number := 1; scanner.Scan(); number++ {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if err := parse(line); err != nil {
+			return fmt.Errorf("%s:%d: %v", file, number, err)
+		}
+	}
+	return scanner.Err()
+}
+
+// authenticate returns the user that sent a request, or "" if it has no
+// valid credentials.
+func (a *Auth) authenticate(r *http.Request) string {
+	// the server only accepts client certificates signed by --tls-ca
+	if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 {
+		return r.TLS.PeerCertificates[0].Subject.CommonName
+	}
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "Bearer ") {
+		return ""
+	}
+	token := []byte(strings.TrimPrefix(header, "Bearer "))
+	user := ""
+	// every token is compared, so the time taken doesn't tell how close a guess was
+	for known, name := range a.tokens {
+		if subtle.ConstantTimeCompare(token, []byte(known)) == 1 {
+			user = name
+		}
+	}
+	return user
+}
+
+// Authenticate rejects the requests without valid credentials and records
+// the user of the others.
+func (a *Auth) Authenticate(handler http.Handler) http.Handler {
+	if a == nil {
+		return handler
+	}
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		user := a.authenticate(r)
+		if len(user) == 0 {
+			rw.Header().Set("WWW-Authenticate", "Bearer")
+			writeError(rw, apiError(http.StatusUnauthorized, "a bearer token or client certificate is required"))
+			return
+		}
+		setRequestUser(r, user)
+		handler.ServeHTTP(rw, r.WithContext(context.WithValue(r.Context(), userKey{}, user)))
+	})
+}
+
+// Allowed reports whether the user of a request has a role on a service.
+func (a *Auth) Allowed(r *http.Request, role Role, name string) bool {
+	if a == nil {
+		return true
+	}
+	for _, g := range a.grants[User(r)] {
+		if g.role < role {
+			continue
+		}
+		for _, pattern := range g.patterns {
+			if matched, _ := path.Match(pattern, name); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// forbidden explains why a request isn't allowed.
+func forbidden(r *http.Request, role Role, name string) *models.Error {
+	return apiError(http.StatusForbidden, fmt.Sprintf("%s isn't a %s of service %s", User(r), role, name))
+}
+
+// User returns the authenticated user of a request, "" when authentication
+// is off.
+func User(r *http.Request) string {
+	user, _ := r.Context().Value(userKey{}).(string)
+	return user
+}
+
+// writeError sends an error from outside the generated handlers.
+func writeError(rw http.ResponseWriter, payload *models.Error) {
+	rw.Header().Set(runtime.HeaderContentType, runtime.JSONMime)
+	rw.WriteHeader(int(payload.Code))
+	json.NewEncoder(rw).Encode(payload)
+}
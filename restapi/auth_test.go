@@ -0,0 +1,189 @@
+package restapi
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"testing"
+)
+
+const testPolicy = `# who may do what
+alice admin
+bob deployer shop-* web
+carol reader
+`
+
+const testTokens = `alice-token,alice
+bob-token, bob
+`
+
+// newTestAuth writes a policy and tokens to files and reads them.
+func newTestAuth(t *testing.T, policy, tokens string) (*Auth, error) {
+	t.Helper()
+	dir := t.TempDir()
+	opts := &AuthOptions{Policy: path.Join(dir, "policy")}
+	if err := ioutil.WriteFile(opts.Policy, []byte(policy), 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tokens) > 0 {
+		opts.Tokens = path.Join(dir, "tokens")
+		if err := ioutil.WriteFile(opts.Tokens, []byte(tokens), 0600); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	return NewAuth(opts)
+}
+
+// authenticated runs a request through Authenticate, and returns the
+// response and the user the handler saw.
+func authenticated(auth *Auth, r *http.Request) (*httptest.ResponseRecorder, string) {
+	user := ""
+	handler := auth.Authenticate(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		user = User(r)
+	}))
+	rw := httptest.NewRecorder()
+	handler.ServeHTTP(rw, r)
+	return rw, user
+}
+
+func TestAllowed(t *testing.T) {
+	auth, err := newTestAuth(t, testPolicy, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tests := []struct {
+		user    string
+		role    Role
+		service string
+		allowed bool
+	}{
+		{user: "alice", role: RoleAdmin, service: "anything", allowed: true},
+		{user: "bob", role: RoleReader, service: "shop-web", allowed: true},
+		{user: "bob", role: RoleDeployer, service: "shop-web", allowed: true},
+		{user: "bob", role: RoleDeployer, service: "web", allowed: true},
+		{user: "bob", role: RoleAdmin, service: "shop-web", allowed: false},
+		{user: "bob", role: RoleReader, service: "shop", allowed: false},
+		{user: "bob", role: RoleReader, service: "db", allowed: false},
+		{user: "carol", role: RoleReader, service: "db", allowed: true},
+		{user: "carol", role: RoleDeployer, service: "db", allowed: false},
+		{user: "dave", role: RoleReader, service: "db", allowed: false},
+		{user: "", role: RoleReader, service: "db", allowed: false},
+	}
+	for _, test := range tests {
+		r := authAs(httptest.NewRequest("GET", "/services", nil), test.user)
+		if allowed := auth.Allowed(r, test.role, test.service); allowed != test.allowed {
+			t.Errorf("%q as %s of %s: allowed = %v, expected %v", test.user, test.role, test.service, allowed, test.allowed)
+		}
+	}
+
+	var open *Auth
+	r := httptest.NewRequest("GET", "/services", nil)
+	if !open.Allowed(r, RoleAdmin, "anything") {
+		t.Errorf("expected a nil Auth to allow everything")
+	}
+}
+
+// authAs returns a request that was authenticated as user.
+func authAs(r *http.Request, user string) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), userKey{}, user))
+}
+
+func TestAuthenticate(t *testing.T) {
+	auth, err := newTestAuth(t, testPolicy, testTokens)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	verified := &tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "carol"}}},
+	}
+	verified.VerifiedChains = [][]*x509.Certificate{verified.PeerCertificates}
+	unverified := &tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "carol"}}},
+	}
+	tests := []struct {
+		name   string
+		header string
+		tls    *tls.ConnectionState
+		user   string
+	}{
+		{name: "token", header: "Bearer alice-token", user: "alice"},
+		{name: "token with spaces in the file", header: "Bearer bob-token", user: "bob"},
+		{name: "unknown token", header: "Bearer mallory-token"},
+		{name: "not a bearer token", header: "Basic YWxpY2U6c2VjcmV0"},
+		{name: "no credentials"},
+		{name: "verified certificate", tls: verified, user: "carol"},
+		{name: "certificate before token", header: "Bearer alice-token", tls: verified, user: "carol"},
+		{name: "unverified certificate", tls: unverified},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/services", nil)
+			r.TLS = test.tls
+			if len(test.header) > 0 {
+				r.Header.Set("Authorization", test.header)
+			}
+			rw, user := authenticated(auth, r)
+			if len(test.user) == 0 {
+				if rw.Code != http.StatusUnauthorized {
+					t.Errorf("expected %d, got %d", http.StatusUnauthorized, rw.Code)
+				}
+				if rw.Header().Get("WWW-Authenticate") != "Bearer" {
+					t.Errorf("expected a Bearer challenge, got %v", rw.Header())
+				}
+				return
+			}
+			if rw.Code != http.StatusOK || user != test.user {
+				t.Errorf("expected %s to be let in, got %d as %q", test.user, rw.Code, user)
+			}
+		})
+	}
+
+	var open *Auth
+	if _, user := authenticated(open, httptest.NewRequest("GET", "/services", nil)); user != "" {
+		t.Errorf("expected no user without authentication, got %q", user)
+	}
+}
+
+func TestNewAuth(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy string
+		tokens string
+		err    string
+	}{
+		{name: "policy", policy: testPolicy, tokens: testTokens},
+		{name: "missing role", policy: "alice\n", err: "expected 'user role [pattern...]'"},
+		{name: "unknown role", policy: "alice owner\n", err: `unknown role "owner"`},
+		{name: "bad pattern", policy: "alice admin [\n", err: `bad pattern "["`},
+		{name: "bad token", policy: testPolicy, tokens: "alice-token\n", err: "expected 'token,user'"},
+		{name: "empty user", policy: testPolicy, tokens: "alice-token,\n", err: "expected 'token,user'"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			auth, err := newTestAuth(t, test.policy, test.tokens)
+			if len(test.err) == 0 {
+				if err != nil || auth == nil {
+					t.Errorf("expected an Auth, got %v, %v", auth, err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("expected an error containing %q, got %v", test.err, err)
+			}
+		})
+	}
+
+	auth, err := NewAuth(&AuthOptions{})
+	if auth != nil || err != nil {
+		t.Errorf("expected no Auth without a policy, got %v, %v", auth, err)
+	}
+	if _, err := NewAuth(&AuthOptions{Tokens: "tokens"}); err == nil {
+		t.Errorf("expected tokens without a policy to be refused")
+	}
+}
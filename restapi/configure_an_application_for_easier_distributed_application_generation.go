@@ -4,10 +4,13 @@ package restapi
 
 import (
 	"crypto/tls"
+	"log"
 	"net/http"
 
 	errors "github.com/go-openapi/errors"
 	runtime "github.com/go-openapi/runtime"
+	swag "github.com/go-openapi/swag"
+	"github.com/prometheus/client_golang/prometheus"
 	graceful "github.com/tylerb/graceful"
 
 	"github.com/metaparticle-io/metaparticle-ast/restapi/operations"
@@ -18,8 +21,37 @@ import (
 
 //go:generate swagger generate server --target .. --name  --spec ../api.yaml
 
+var storeOptions = &StoreOptions{}
+
+var authOptions = &AuthOptions{}
+
+var auditOptions = &AuditOptions{}
+
+var registryOptions = &RegistryOptions{}
+
 func configureFlags(api *operations.AnApplicationForEasierDistributedApplicationGenerationAPI) {
-	// api.CommandLineOptionsGroups = []swag.CommandLineOptionsGroup{ ... }
+	api.CommandLineOptionsGroups = []swag.CommandLineOptionsGroup{
+		{
+			ShortDescription: "Store Options",
+			LongDescription:  "Where the server keeps its services",
+			Options:          storeOptions,
+		},
+		{
+			ShortDescription: "Auth Options",
+			LongDescription:  "Who may use the server, client certificates are verified with --tls-ca",
+			Options:          authOptions,
+		},
+		{
+			ShortDescription: "Audit Options",
+			LongDescription:  "Where the server records the changes made through it",
+			Options:          auditOptions,
+		},
+		{
+			ShortDescription: "Registry Options",
+			LongDescription:  "Which registry credentials of the server specs may use",
+			Options:          registryOptions,
+		},
+	}
 }
 
 func configureAPI(api *operations.AnApplicationForEasierDistributedApplicationGenerationAPI) http.Handler {
@@ -36,7 +68,23 @@ func configureAPI(api *operations.AnApplicationForEasierDistributedApplicationGe
 
 	api.JSONProducer = runtime.JSONProducer()
 
-	impl := &Impl{}
+	store, err := NewStore(storeOptions)
+	if err != nil {
+		log.Fatalf("Failed to open the store: %v", err)
+	}
+	auth, err := NewAuth(authOptions)
+	if err != nil {
+		log.Fatalf("Failed to read the auth files: %v", err)
+	}
+	audit, err := NewAuditLog(auditOptions)
+	if err != nil {
+		log.Fatalf("Failed to open the audit log: %v", err)
+	}
+	impl, err := NewImpl(store, auth, audit, registryOptions)
+	if err != nil {
+		log.Fatalf("Failed to read the store: %v", err)
+	}
+	prometheus.MustRegister(impl.servicesGauge())
 
 	api.ServicesListServicesHandler = services.ListServicesHandlerFunc(impl.HandleListServices)
 
@@ -46,14 +94,38 @@ func configureAPI(api *operations.AnApplicationForEasierDistributedApplicationGe
 
 	api.ServicesCreateOrUpdateServiceHandler = services.CreateOrUpdateServiceHandlerFunc(impl.HandleUpdateOne)
 
+	api.ServicesDeployServiceHandler = services.DeployServiceHandlerFunc(impl.HandleDeploy)
+
+	api.ServicesUndeployServiceHandler = services.UndeployServiceHandlerFunc(impl.HandleUndeploy)
+
+	api.ServicesGetServiceStatusHandler = services.GetServiceStatusHandlerFunc(impl.HandleStatus)
+
+	api.ServicesGetServiceLogsHandler = services.GetServiceLogsHandlerFunc(impl.HandleLogs)
+
+	api.ServicesListServiceRevisionsHandler = services.ListServiceRevisionsHandlerFunc(impl.HandleListRevisions)
+
+	api.ServicesGetServiceRevisionHandler = services.GetServiceRevisionHandlerFunc(impl.HandleGetRevision)
+
+	api.ServicesRollbackServiceHandler = services.RollbackServiceHandlerFunc(impl.HandleRollback)
+
+	api.ServicesCreatePlanHandler = services.CreatePlanHandlerFunc(impl.HandleCreatePlan)
+
+	api.ServicesListAuditEntriesHandler = services.ListAuditEntriesHandlerFunc(impl.HandleListAuditEntries)
+
 	api.ServerShutdown = func() {}
 
-	return setupGlobalMiddleware(api.Serve(setupMiddlewares))
+	return setupGlobalMiddleware(api.Serve(func(handler http.Handler) http.Handler {
+		return setupMiddlewares(auth.Authenticate(handler))
+	}))
 }
 
 // The TLS configuration before HTTPS server starts.
 func configureTLS(tlsConfig *tls.Config) {
 	// Make all necessary changes to the TLS configuration here.
+	if len(authOptions.Policy) > 0 && tlsConfig.ClientAuth == tls.RequireAndVerifyClientCert {
+		// users without a client certificate authenticate with a bearer token
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
 }
 
 // As soon as server is initialized but not run yet, this function will be called.
@@ -66,11 +138,11 @@ func configureServer(s *graceful.Server, scheme, addr string) {
 // The middleware configuration is for the handler executors. These do not apply to the swagger.json document.
 // The middleware executes after routing but before authentication, binding and validation
 func setupMiddlewares(handler http.Handler) http.Handler {
-	return handler
+	return recordRoute(handler)
 }
 
 // The middleware configuration happens before anything, this middleware also applies to serving the swagger.json document.
 // So this is a good place to plug in a panic handling middleware, logging and metrics
 func setupGlobalMiddleware(handler http.Handler) http.Handler {
-	return handler
+	return instrument(handler)
 }
@@ -0,0 +1,210 @@
+package restapi
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"runtime/debug"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/metaparticle-io/metaparticle-ast/compiler"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+	"github.com/metaparticle-io/metaparticle-ast/restapi/operations/services"
+)
+
+const defaultBackend = models.DeploymentBackendKubernetes
+
+// compilerFor returns the compiler for a backend, creating it on first use.
+// The Kubernetes compiler registers a flag, so it can only be created once.
+func (i *Impl) compilerFor(backend string) (compiler.Compiler, error) {
+	i.compilersLock.Lock()
+	defer i.compilersLock.Unlock()
+	if cmp, found := i.compilers[backend]; found {
+		return cmp, nil
+	}
+	var cmp compiler.Compiler
+	var err error
+	switch backend {
+	case models.DeploymentBackendKubernetes:
+		cmp, err = compiler.NewKubernetesCompiler()
+	case models.DeploymentBackendDocker:
+		cmp = compiler.NewDockerCompiler()
+	case models.DeploymentBackendAci:
+		cmp = compiler.NewAciCompiler()
+	default:
+		return nil, fmt.Errorf("unknown backend: %s", backend)
+	}
+	if err != nil {
+		return nil, err
+	}
+	i.compilers[backend] = cmp
+	return cmp, nil
+}
+
+// execute compiles the named service for a backend and runs the plan. The
+// commands and objects of the plan are returned along with its outcome,
+// which is also added to the audit entry.
+func (i *Impl) execute(name string, backend *string, dryRun *bool, undeploy bool, entry *models.AuditEntry) (*models.Deployment, *models.Error) {
+	i.Lock()
+	svc, err := i.store.Get(name)
+	i.Unlock()
+	if err != nil {
+		return nil, apiError(http.StatusInternalServerError, err.Error())
+	}
+	if svc == nil {
+		return nil, apiError(http.StatusNotFound, "service "+name+" not found")
+	}
+	// specs stored before the server changed its registry flags are checked again
+	if !undeploy {
+		if err := i.registries.checkRegistries(svc); err != nil {
+			return nil, apiError(http.StatusUnprocessableEntity, err.Error())
+		}
+	}
+
+	result := &models.Deployment{
+		Backend: swag.String(defaultBackend),
+		DryRun:  dryRun != nil && *dryRun,
+	}
+	if backend != nil {
+		result.Backend = backend
+	}
+	entry.Backend = *result.Backend
+	entry.DryRun = result.DryRun
+	if undeploy {
+		entry.OldDigest = specDigest(svc)
+	} else {
+		entry.NewDigest = specDigest(svc)
+	}
+	cmp, err := i.compilerFor(*result.Backend)
+	if err != nil {
+		return nil, apiError(http.StatusInternalServerError, err.Error())
+	}
+
+	output := &bytes.Buffer{}
+	opts := &compiler.CompilerOptions{Output: output}
+	var plan compiler.Plan
+	if undeploy {
+		plan, err = cmp.Delete(opts, svc)
+	} else {
+		plan, err = cmp.Compile(opts, svc)
+	}
+	if err == nil {
+		err = i.runPlan(plan, result.DryRun)
+	}
+	if !result.DryRun {
+		countDeployment(*result.Backend, undeploy, err)
+	}
+	if panicked, ok := err.(*planPanic); ok {
+		return nil, apiError(http.StatusInternalServerError, panicked.Error())
+	}
+	result.Plan = output.String()
+	result.Succeeded = swag.Bool(err == nil)
+	if err != nil {
+		result.Error = err.Error()
+	}
+	entry.Succeeded = result.Succeeded
+	entry.Message = result.Error
+	if err == nil && !result.DryRun {
+		deployed := *result.Backend
+		if undeploy {
+			deployed = ""
+		}
+		if err := i.setDeployedBackend(name, deployed); err != nil {
+			return nil, apiError(http.StatusInternalServerError, err.Error())
+		}
+	}
+	return result, nil
+}
+
+// planPanic is the error of a plan that panicked.
+type planPanic struct {
+	value interface{}
+}
+
+func (p *planPanic) Error() string {
+	return fmt.Sprintf("the plan failed unexpectedly: %v", p.value)
+}
+
+// runPlan executes a plan holding the deploy lock, plans run commands
+// against shared infrastructure one at a time. A panic in the plan is
+// returned as a *planPanic, so the lock is released and the request still
+// gets an answer and an audit entry.
+func (i *Impl) runPlan(plan compiler.Plan, dryRun bool) (err error) {
+	i.deployLock.Lock()
+	defer i.deployLock.Unlock()
+	defer func() {
+		if value := recover(); value != nil {
+			log.Printf("Plan panicked: %v\n%s", value, debug.Stack())
+			err = &planPanic{value}
+		}
+	}()
+	return plan.Execute(dryRun)
+}
+
+// setDeployedBackend records where a service is deployed, or that it isn't
+// when the backend is empty. It isn't a change to the spec, so no revision
+// is kept for it.
+func (i *Impl) setDeployedBackend(name string, backend string) error {
+	i.Lock()
+	defer i.Unlock()
+	svc, err := i.store.Get(name)
+	if err != nil || svc == nil || svc.DeployedBackend == backend {
+		return err
+	}
+	// the stored service may be shared with its revisions
+	if svc, err = copyService(svc); err != nil {
+		return err
+	}
+	svc.DeployedBackend = backend
+	if err := i.store.Put(name, svc); err != nil {
+		return err
+	}
+	i.publish(models.WatchEventTypeMODIFIED, svc)
+	return nil
+}
+
+// HandleDeploy implements the DeployServiceHandler interface
+func (i *Impl) HandleDeploy(params services.DeployServiceParams) middleware.Responder {
+	entry := newAuditEntry(params.HTTPRequest, models.AuditEntryOperationDeploy, params.Name)
+	return i.audited(entry, func() middleware.Responder {
+		return i.deploy(params, entry)
+	})
+}
+
+func (i *Impl) deploy(params services.DeployServiceParams, entry *models.AuditEntry) middleware.Responder {
+	if !i.auth.Allowed(params.HTTPRequest, RoleDeployer, params.Name) {
+		return services.NewDeployServiceDefault(http.StatusForbidden).WithPayload(forbidden(params.HTTPRequest, RoleDeployer, params.Name))
+	}
+	result, apiErr := i.execute(params.Name, params.Backend, params.DryRun, false, entry)
+	if apiErr == nil {
+		return services.NewDeployServiceOK().WithPayload(result)
+	}
+	if apiErr.Code == http.StatusNotFound {
+		return services.NewDeployServiceNotFound().WithPayload(apiErr)
+	}
+	return services.NewDeployServiceDefault(int(apiErr.Code)).WithPayload(apiErr)
+}
+
+// HandleUndeploy implements the UndeployServiceHandler interface
+func (i *Impl) HandleUndeploy(params services.UndeployServiceParams) middleware.Responder {
+	entry := newAuditEntry(params.HTTPRequest, models.AuditEntryOperationUndeploy, params.Name)
+	return i.audited(entry, func() middleware.Responder {
+		return i.undeploy(params, entry)
+	})
+}
+
+func (i *Impl) undeploy(params services.UndeployServiceParams, entry *models.AuditEntry) middleware.Responder {
+	if !i.auth.Allowed(params.HTTPRequest, RoleDeployer, params.Name) {
+		return services.NewUndeployServiceDefault(http.StatusForbidden).WithPayload(forbidden(params.HTTPRequest, RoleDeployer, params.Name))
+	}
+	result, apiErr := i.execute(params.Name, params.Backend, params.DryRun, true, entry)
+	if apiErr == nil {
+		return services.NewUndeployServiceOK().WithPayload(result)
+	}
+	if apiErr.Code == http.StatusNotFound {
+		return services.NewUndeployServiceNotFound().WithPayload(apiErr)
+	}
+	return services.NewUndeployServiceDefault(int(apiErr.Code)).WithPayload(apiErr)
+}
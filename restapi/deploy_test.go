@@ -0,0 +1,100 @@
+package restapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/metaparticle-io/metaparticle-ast/compiler"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+	"github.com/metaparticle-io/metaparticle-ast/restapi/operations/services"
+)
+
+// panickingCompiler compiles plans that panic when they are executed.
+type panickingCompiler struct {
+	compiler.Compiler
+}
+
+func (panickingCompiler) Compile(opts *compiler.CompilerOptions, svc *models.Service) (compiler.Plan, error) {
+	return panickingPlan{}, nil
+}
+
+func (panickingCompiler) Delete(opts *compiler.CompilerOptions, svc *models.Service) (compiler.Plan, error) {
+	return panickingPlan{}, nil
+}
+
+type panickingPlan struct{}
+
+func (panickingPlan) Execute(dryrun bool) error {
+	panic("nil pointer")
+}
+
+func (panickingPlan) Dump(directory string) error {
+	return nil
+}
+
+func TestPlanPanics(t *testing.T) {
+	requests := map[string]func(impl *Impl) middleware.Responder{
+		"deploy": func(impl *Impl) middleware.Responder {
+			return impl.HandleDeploy(services.DeployServiceParams{
+				HTTPRequest: httptest.NewRequest(http.MethodPost, "/services/web/deploy", nil),
+				Name:        "web",
+				Backend:     swag.String(models.DeploymentBackendDocker),
+			})
+		},
+		"undeploy": func(impl *Impl) middleware.Responder {
+			return impl.HandleUndeploy(services.UndeployServiceParams{
+				HTTPRequest: httptest.NewRequest(http.MethodPost, "/services/web/undeploy", nil),
+				Name:        "web",
+				Backend:     swag.String(models.DeploymentBackendDocker),
+			})
+		},
+		"plan": func(impl *Impl) middleware.Responder {
+			return impl.HandleCreatePlan(services.CreatePlanParams{
+				HTTPRequest: httptest.NewRequest(http.MethodPost, "/plans", nil),
+				Request:     &models.PlanRequest{Name: "web", Backend: models.PlanRequestBackendDocker},
+			})
+		},
+	}
+	for name, request := range requests {
+		t.Run(name, func(t *testing.T) {
+			impl := newTestImpl(t, nil)
+			put(t, impl, "web", `{"name": "web"}`, "")
+			impl.compilers[models.DeploymentBackendDocker] = panickingCompiler{}
+
+			// a plan that panicked doesn't keep the deploy lock
+			for attempt := 0; attempt < 2; attempt++ {
+				responses := make(chan int)
+				go func() {
+					responses <- respond(request(impl)).Code
+				}()
+				select {
+				case code := <-responses:
+					if code != http.StatusInternalServerError {
+						t.Fatalf("expected %d, got %d", http.StatusInternalServerError, code)
+					}
+				case <-time.After(5 * time.Second):
+					t.Fatalf("the request after a panic never finished")
+				}
+			}
+			if name == "plan" {
+				// plans change nothing, so they aren't audited
+				return
+			}
+			entries, _ := impl.audit.Entries(func(entry *models.AuditEntry) bool {
+				return *entry.Operation == name && !entry.Pending
+			})
+			if len(entries) != 2 {
+				t.Fatalf("expected 2 outcomes, got %v", entries)
+			}
+			entry := entries[0]
+			if *entry.Code != http.StatusInternalServerError || *entry.Succeeded || !strings.Contains(entry.Message, "nil pointer") {
+				t.Errorf("unexpected entry %#v", entry)
+			}
+		})
+	}
+}
@@ -30,20 +30,204 @@ func init() {
     "version": "0.0.1"
   },
   "paths": {
+    "/audit": {
+      "get": {
+        "tags": [
+          "services"
+        ],
+        "operationId": "listAuditEntries",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "only the entries at or after this time",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "only the entries before this time",
+            "name": "until",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "only the entries of this service",
+            "name": "service",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the audit log, oldest first",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/auditEntry"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/plans": {
+      "post": {
+        "tags": [
+          "services"
+        ],
+        "operationId": "createPlan",
+        "parameters": [
+          {
+            "name": "request",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/planRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the rendered plan, nothing was executed",
+            "schema": {
+              "$ref": "#/definitions/plan"
+            }
+          },
+          "400": {
+            "description": "the request names neither a stored service nor a spec, or both",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "the stored service doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "the spec is invalid or can't be planned for the backend",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/services": {
       "get": {
+        "produces": [
+          "application/json",
+          "application/x-ndjson",
+          "text/event-stream"
+        ],
         "tags": [
           "services"
         ],
         "operationId": "listServices",
+        "parameters": [
+          {
+            "type": "boolean",
+            "description": "stream the changes to the services instead of listing them",
+            "name": "watch",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "with watch, only stream the changes after this resource version. Without it every service is sent as ADDED first",
+            "name": "resourceVersion",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "only the services whose labels match, like app=web,tier!=db",
+            "name": "labelSelector",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "only the services whose names start with this",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "kubernetes",
+              "docker",
+              "aci"
+            ],
+            "type": "string",
+            "description": "only the services deployed to this backend",
+            "name": "backend",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "only the services that are, or aren't, deployed",
+            "name": "deployed",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int32",
+            "description": "the most services to return, the rest are fetched with the continue token",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "the token of the previous page, from its X-Continue header",
+            "name": "continue",
+            "in": "query"
+          }
+        ],
         "responses": {
           "200": {
-            "description": "list the services",
+            "description": "list the services ordered by name, or with watch a stream of watchEvent objects, one JSON object per line or one server-sent event each",
             "schema": {
               "type": "array",
               "items": {
                 "$ref": "#/definitions/service"
               }
+            },
+            "headers": {
+              "X-Continue": {
+                "type": "string",
+                "description": "the token that fetches the next page, missing on the last one"
+              }
+            }
+          },
+          "400": {
+            "description": "the label selector or continue token is invalid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "410": {
+            "description": "the changes after resourceVersion are no longer known, list the services and watch from there",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
         }
@@ -60,6 +244,12 @@ func init() {
             "description": "OK",
             "schema": {
               "$ref": "#/definitions/service"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "the resource version of the service"
+              }
             }
           },
           "default": {
@@ -82,6 +272,24 @@ func init() {
             "schema": {
               "$ref": "#/definitions/service"
             }
+          },
+          {
+            "type": "string",
+            "description": "only update the service if it still has one of these ETags",
+            "name": "If-Match",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "who made the change, kept in the revision history",
+            "name": "author",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "what the change does, kept in the revision history",
+            "name": "summary",
+            "in": "query"
           }
         ],
         "responses": {
@@ -89,6 +297,36 @@ func init() {
             "description": "OK",
             "schema": {
               "$ref": "#/definitions/service"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "the new resource version of the service"
+              }
+            }
+          },
+          "400": {
+            "description": "the body is missing or doesn't match the path",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "the resource version of the body isn't the stored one",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "412": {
+            "description": "If-Match doesn't match the stored service",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "the service is invalid",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "default": {
@@ -104,10 +342,182 @@ func init() {
           "services"
         ],
         "operationId": "deleteService",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "only delete the service if it still has one of these ETags",
+            "name": "If-Match",
+            "in": "header"
+          }
+        ],
         "responses": {
           "204": {
             "description": "Deleted"
           },
+          "412": {
+            "description": "If-Match doesn't match the stored service",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/services/{name}/deploy": {
+      "post": {
+        "tags": [
+          "services"
+        ],
+        "operationId": "deployService",
+        "parameters": [
+          {
+            "enum": [
+              "kubernetes",
+              "docker",
+              "aci"
+            ],
+            "type": "string",
+            "name": "backend",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "dryRun",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/deployment"
+            }
+          },
+          "404": {
+            "description": "the service doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/services/{name}/logs": {
+      "get": {
+        "produces": [
+          "application/json",
+          "application/x-ndjson",
+          "text/event-stream"
+        ],
+        "tags": [
+          "services"
+        ],
+        "operationId": "getServiceLogs",
+        "parameters": [
+          {
+            "enum": [
+              "kubernetes",
+              "docker",
+              "aci"
+            ],
+            "type": "string",
+            "name": "backend",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "follow",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "container",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "since",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "a stream of logEvent objects, one JSON object per line or one server-sent \"log\" event each. A failure after the stream started ends it with an error object, sent as an \"error\" event to event streams"
+          },
+          "404": {
+            "description": "the service doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/services/{name}/revisions": {
+      "get": {
+        "tags": [
+          "services"
+        ],
+        "operationId": "listServiceRevisions",
+        "responses": {
+          "200": {
+            "description": "the revisions of the service, oldest first",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/revision"
+              }
+            }
+          },
+          "404": {
+            "description": "the service has no revisions",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "default": {
             "description": "error",
             "schema": {
@@ -115,99 +525,815 @@ func init() {
             }
           }
         }
-      },
-      "parameters": [
-        {
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/services/{name}/revisions/{revision}": {
+      "get": {
+        "tags": [
+          "services"
+        ],
+        "operationId": "getServiceRevision",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/revision"
+            }
+          },
+          "404": {
+            "description": "the service has no such revision",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "integer",
+          "format": "int64",
+          "name": "revision",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/services/{name}/rollback": {
+      "post": {
+        "tags": [
+          "services"
+        ],
+        "operationId": "rollbackService",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "revision",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "who rolled the service back, kept in the revision history",
+            "name": "author",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "redeploy the restored service",
+            "name": "deploy",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "kubernetes",
+              "docker",
+              "aci"
+            ],
+            "type": "string",
+            "name": "backend",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "dryRun",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/rollback"
+            }
+          },
+          "404": {
+            "description": "the service has no such revision",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/services/{name}/status": {
+      "get": {
+        "tags": [
+          "services"
+        ],
+        "operationId": "getServiceStatus",
+        "parameters": [
+          {
+            "enum": [
+              "kubernetes",
+              "docker",
+              "aci"
+            ],
+            "type": "string",
+            "name": "backend",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/status"
+            }
+          },
+          "404": {
+            "description": "the service doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/services/{name}/undeploy": {
+      "post": {
+        "tags": [
+          "services"
+        ],
+        "operationId": "undeployService",
+        "parameters": [
+          {
+            "enum": [
+              "kubernetes",
+              "docker",
+              "aci"
+            ],
+            "type": "string",
+            "name": "backend",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "dryRun",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/deployment"
+            }
+          },
+          "404": {
+            "description": "the service doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    }
+  },
+  "definitions": {
+    "auditEntry": {
+      "type": "object",
+      "required": [
+        "id",
+        "timestamp",
+        "service",
+        "operation",
+        "code",
+        "succeeded"
+      ],
+      "properties": {
+        "backend": {
+          "type": "string"
+        },
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "dryRun": {
+          "type": "boolean"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "intentId": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "message": {
+          "type": "string"
+        },
+        "newDigest": {
+          "type": "string"
+        },
+        "oldDigest": {
+          "type": "string"
+        },
+        "operation": {
+          "type": "string",
+          "enum": [
+            "create",
+            "update",
+            "delete",
+            "rollback",
+            "deploy",
+            "undeploy"
+          ]
+        },
+        "pending": {
+          "type": "boolean"
+        },
+        "remoteAddr": {
+          "type": "string"
+        },
+        "service": {
+          "type": "string"
+        },
+        "succeeded": {
+          "type": "boolean"
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "user": {
+          "type": "string"
+        }
+      }
+    },
+    "build": {
+      "type": "object",
+      "properties": {
+        "imageName": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        }
+      }
+    },
+    "container": {
+      "type": "object",
+      "required": [
+        "image"
+      ],
+      "properties": {
+        "env": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/envVar"
+          }
+        },
+        "image": {
+          "type": "string"
+        },
+        "imagePullPolicy": {
+          "type": "string",
+          "enum": [
+            "Always",
+            "IfNotPresent",
+            "Never"
+          ]
+        }
+      }
+    },
+    "deployment": {
+      "type": "object",
+      "required": [
+        "backend",
+        "succeeded"
+      ],
+      "properties": {
+        "backend": {
+          "type": "string",
+          "enum": [
+            "kubernetes",
+            "docker",
+            "aci"
+          ]
+        },
+        "dryRun": {
+          "type": "boolean"
+        },
+        "error": {
+          "type": "string"
+        },
+        "plan": {
+          "type": "string"
+        },
+        "succeeded": {
+          "type": "boolean"
+        }
+      }
+    },
+    "disruptionBudget": {
+      "type": "object",
+      "properties": {
+        "maxUnavailable": {
+          "type": "string",
+          "pattern": "^[0-9]+%?$"
+        },
+        "minAvailable": {
+          "type": "string",
+          "pattern": "^[0-9]+%?$"
+        }
+      }
+    },
+    "envVar": {
+      "type": "object",
+      "required": [
+        "name",
+        "value"
+      ],
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "value": {
+          "type": "string"
+        }
+      }
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "message"
+      ],
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "jobSpecification": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "activeDeadlineSeconds": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "backoffLimit": {
+          "type": "integer",
+          "format": "int32",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "containers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/container"
+          }
+        },
+        "depends": {
+          "type": "string"
+        },
+        "indexed": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        },
+        "parallelism": {
+          "type": "integer",
+          "format": "int32",
+          "minimum": 1
+        },
+        "placement": {
+          "$ref": "#/definitions/placementSpecification"
+        },
+        "replicas": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "restartPolicy": {
+          "type": "string",
+          "enum": [
+            "OnFailure",
+            "Never"
+          ]
+        },
+        "schedule": {
+          "type": "string"
+        },
+        "securityContext": {
+          "$ref": "#/definitions/securityContext"
+        },
+        "serviceAccountName": {
+          "type": "string"
+        },
+        "ttlSecondsAfterFinished": {
+          "type": "integer",
+          "format": "int32",
+          "minimum": 0,
+          "x-nullable": true
+        }
+      }
+    },
+    "jobStatus": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "active": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "completions": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "failed": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "failures": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "succeeded": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "labelRequirement": {
+      "type": "object",
+      "required": [
+        "key",
+        "operator"
+      ],
+      "properties": {
+        "key": {
+          "type": "string"
+        },
+        "operator": {
+          "type": "string",
+          "enum": [
+            "In",
+            "NotIn",
+            "Exists",
+            "DoesNotExist"
+          ]
+        },
+        "values": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "logEvent": {
+      "type": "object",
+      "required": [
+        "message"
+      ],
+      "properties": {
+        "container": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "pod": {
+          "type": "string"
+        },
+        "service": {
+          "type": "string"
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "placementSpecification": {
+      "type": "object",
+      "properties": {
+        "nodeAffinity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/labelRequirement"
+          }
+        },
+        "nodeSelector": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "podAffinity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/podAffinityTerm"
+          }
+        },
+        "podAntiAffinity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/podAffinityTerm"
+          }
+        },
+        "spreadAcrossZones": {
+          "type": "boolean"
+        },
+        "tolerations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/toleration"
+          }
+        }
+      }
+    },
+    "plan": {
+      "type": "object",
+      "required": [
+        "backend",
+        "steps",
+        "manifest"
+      ],
+      "properties": {
+        "backend": {
+          "type": "string"
+        },
+        "manifest": {
+          "type": "string"
+        },
+        "steps": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/planStep"
+          }
+        },
+        "undeploy": {
+          "type": "boolean"
+        }
+      }
+    },
+    "planRequest": {
+      "type": "object",
+      "properties": {
+        "backend": {
           "type": "string",
-          "name": "name",
-          "in": "path",
-          "required": true
+          "enum": [
+            "kubernetes",
+            "docker",
+            "aci"
+          ]
+        },
+        "name": {
+          "type": "string"
+        },
+        "service": {
+          "$ref": "#/definitions/service"
+        },
+        "undeploy": {
+          "type": "boolean"
         }
-      ]
-    }
-  },
-  "definitions": {
-    "build": {
+      }
+    },
+    "planStep": {
       "type": "object",
       "properties": {
-        "imageName": {
+        "command": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "description": {
           "type": "string"
         },
         "name": {
           "type": "string"
         },
-        "path": {
-          "type": "string"
+        "object": {
+          "type": "object"
         }
       }
     },
-    "container": {
+    "podAffinityTerm": {
       "type": "object",
       "required": [
-        "image"
+        "service"
       ],
       "properties": {
-        "env": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/envVar"
-          }
+        "preferred": {
+          "type": "boolean"
         },
-        "image": {
+        "service": {
+          "type": "string"
+        },
+        "topologyKey": {
           "type": "string"
+        },
+        "weight": {
+          "type": "integer",
+          "format": "int32",
+          "maximum": 100,
+          "minimum": 1
         }
       }
     },
-    "envVar": {
+    "registryCredential": {
       "type": "object",
       "required": [
-        "name",
-        "value"
+        "server"
       ],
       "properties": {
-        "name": {
+        "dockerConfig": {
           "type": "string"
         },
-        "value": {
+        "passwordEnv": {
+          "type": "string"
+        },
+        "secretName": {
+          "type": "string"
+        },
+        "server": {
+          "type": "string"
+        },
+        "username": {
           "type": "string"
         }
       }
     },
-    "error": {
+    "revision": {
       "type": "object",
       "required": [
-        "message"
+        "revision"
       ],
       "properties": {
-        "code": {
+        "author": {
+          "type": "string"
+        },
+        "revision": {
           "type": "integer",
           "format": "int64"
         },
-        "message": {
+        "service": {
+          "$ref": "#/definitions/service"
+        },
+        "summary": {
           "type": "string"
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "date-time"
         }
       }
     },
-    "jobSpecification": {
+    "rollback": {
       "type": "object",
       "required": [
-        "name"
+        "service"
       ],
       "properties": {
-        "containers": {
+        "deployment": {
+          "$ref": "#/definitions/deployment"
+        },
+        "service": {
+          "$ref": "#/definitions/service"
+        }
+      }
+    },
+    "securityContext": {
+      "type": "object",
+      "properties": {
+        "allowPrivilegeEscalation": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "dropCapabilities": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/container"
+            "type": "string"
           }
         },
-        "name": {
+        "fsGroup": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "readOnlyRootFilesystem": {
+          "type": "boolean"
+        },
+        "runAsGroup": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "runAsNonRoot": {
+          "type": "boolean"
+        },
+        "runAsUser": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "seccompProfile": {
+          "type": "string",
+          "pattern": "^(RuntimeDefault|Unconfined|localhost/.+)$"
+        }
+      }
+    },
+    "servePath": {
+      "type": "object",
+      "required": [
+        "path",
+        "service"
+      ],
+      "properties": {
+        "path": {
           "type": "string"
         },
-        "replicas": {
+        "port": {
           "type": "integer",
           "format": "int32"
         },
-        "schedule": {
+        "service": {
           "type": "string"
         }
       }
@@ -218,11 +1344,29 @@ func init() {
         "name"
       ],
       "properties": {
+        "hostnames": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ingressClass": {
+          "type": "string"
+        },
         "name": {
           "type": "string"
         },
+        "paths": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/servePath"
+          }
+        },
         "public": {
           "type": "boolean"
+        },
+        "tlsSecret": {
+          "type": "string"
         }
       }
     },
@@ -233,6 +1377,9 @@ func init() {
         "name"
       ],
       "properties": {
+        "deployedBackend": {
+          "type": "string"
+        },
         "guid": {
           "type": "integer",
           "format": "int64"
@@ -243,14 +1390,36 @@ func init() {
             "$ref": "#/definitions/jobSpecification"
           }
         },
+        "labels": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
         "name": {
           "type": "string",
           "minLength": 1
         },
+        "registries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/registryCredential"
+          }
+        },
+        "resourceVersion": {
+          "type": "integer",
+          "format": "int64"
+        },
         "serve": {
           "type": "object",
           "$ref": "#/definitions/serveSpecification"
         },
+        "serves": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/serveSpecification"
+          }
+        },
         "services": {
           "type": "array",
           "items": {
@@ -265,6 +1434,12 @@ func init() {
         "number"
       ],
       "properties": {
+        "direct": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        },
         "number": {
           "type": "integer",
           "format": "int32"
@@ -289,9 +1464,18 @@ func init() {
         "depends": {
           "type": "string"
         },
+        "disableNetworkPolicy": {
+          "type": "boolean"
+        },
+        "disruptionBudget": {
+          "$ref": "#/definitions/disruptionBudget"
+        },
         "name": {
           "type": "string"
         },
+        "placement": {
+          "$ref": "#/definitions/placementSpecification"
+        },
         "ports": {
           "type": "array",
           "items": {
@@ -305,25 +1489,209 @@ func init() {
           "type": "integer",
           "format": "int32"
         },
+        "securityContext": {
+          "$ref": "#/definitions/securityContext"
+        },
+        "serviceAccountName": {
+          "type": "string"
+        },
         "shardSpec": {
           "$ref": "#/definitions/shardSpecification"
         }
       }
     },
+    "serviceStatus": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "endpoints": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "failures": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "readyReplicas": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "replicas": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "sharder": {
+          "type": "object",
+          "$ref": "#/definitions/sharderStatus"
+        },
+        "shards": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/shardStatus"
+          }
+        }
+      }
+    },
     "shardSpecification": {
       "type": "object",
       "properties": {
         "fieldPath": {
           "type": "string"
         },
+        "header": {
+          "type": "string"
+        },
+        "sharder": {
+          "$ref": "#/definitions/sharderSpecification"
+        },
         "shards": {
           "type": "integer",
           "format": "int32"
         },
+        "spreadAcrossZones": {
+          "type": "boolean"
+        },
         "urlPattern": {
           "type": "string"
         }
       }
+    },
+    "shardStatus": {
+      "type": "object",
+      "properties": {
+        "index": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "ready": {
+          "type": "boolean"
+        }
+      }
+    },
+    "sharderSpecification": {
+      "type": "object",
+      "properties": {
+        "hashing": {
+          "type": "string",
+          "enum": [
+            "modulo",
+            "consistent",
+            "rendezvous"
+          ]
+        },
+        "image": {
+          "type": "string"
+        },
+        "port": {
+          "type": "integer",
+          "format": "int32",
+          "maximum": 65535,
+          "minimum": 1
+        },
+        "replicas": {
+          "type": "integer",
+          "format": "int32",
+          "minimum": 1
+        }
+      }
+    },
+    "sharderStatus": {
+      "type": "object",
+      "properties": {
+        "readyReplicas": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "replicas": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "status": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "backend": {
+          "type": "string"
+        },
+        "jobs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/jobStatus"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "services": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/serviceStatus"
+          }
+        }
+      }
+    },
+    "toleration": {
+      "type": "object",
+      "properties": {
+        "effect": {
+          "type": "string",
+          "enum": [
+            "NoSchedule",
+            "PreferNoSchedule",
+            "NoExecute"
+          ]
+        },
+        "key": {
+          "type": "string"
+        },
+        "operator": {
+          "type": "string",
+          "enum": [
+            "Equal",
+            "Exists"
+          ]
+        },
+        "tolerationSeconds": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "value": {
+          "type": "string"
+        }
+      }
+    },
+    "watchEvent": {
+      "type": "object",
+      "required": [
+        "type",
+        "service"
+      ],
+      "properties": {
+        "service": {
+          "$ref": "#/definitions/service"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "ADDED",
+            "MODIFIED",
+            "DELETED"
+          ]
+        }
+      }
     }
   }
 }`))
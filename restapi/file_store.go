@@ -0,0 +1,290 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/metaparticle-io/metaparticle-ast/models"
+)
+
+const (
+	fileStoreExt = ".json"
+	// versionFile keeps the last resource version, so versions aren't reused
+	// after the newest service is deleted and the server restarts.
+	versionFile = ".version"
+	// revisionsDir has a directory for every service, with a file for each
+	// of its revisions named after the revision.
+	revisionsDir = "revisions"
+)
+
+// fileStore keeps every service as a JSON file in a directory. Files are
+// written to a temporary file, synced and renamed into place, so a crash
+// leaves either the old or the new version of a service.
+type fileStore struct {
+	sync.Mutex
+	dir     string
+	version int64
+}
+
+// NewFileStore creates a store in dir, creating the directory if needed.
+func NewFileStore(dir string) (Store, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	f := &fileStore{dir: dir}
+	data, err := ioutil.ReadFile(path.Join(dir, versionFile))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		if f.version, err = strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	// stores written before versions were kept start after their newest service
+	services, err := f.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, svc := range services {
+		if svc.ResourceVersion > f.version {
+			f.version = svc.ResourceVersion
+		}
+	}
+	return f, nil
+}
+
+// escapeName returns the name of the files of a service. url.PathEscape
+// leaves dots alone, so the names that would refer to a directory are
+// escaped further.
+func escapeName(name string) string {
+	escaped := url.PathEscape(name)
+	if escaped == "." || escaped == ".." {
+		return strings.Replace(escaped, ".", "%2E", -1)
+	}
+	return escaped
+}
+
+// checkName refuses to write a service without a name, its revisions would
+// be kept in the directory of every service's revisions.
+func checkName(name string) error {
+	if len(name) == 0 {
+		return fmt.Errorf("a service name is required")
+	}
+	return nil
+}
+
+func (f *fileStore) file(name string) string {
+	return path.Join(f.dir, escapeName(name)+fileStoreExt)
+}
+
+func (f *fileStore) revisionsDir(name string) string {
+	return path.Join(f.dir, revisionsDir, escapeName(name))
+}
+
+func (f *fileStore) revisionFile(name string, revision int64) string {
+	return path.Join(f.revisionsDir(name), strconv.FormatInt(revision, 10)+fileStoreExt)
+}
+
+func (f *fileStore) read(file string) (*models.Service, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	svc := &models.Service{}
+	if err := json.Unmarshal(data, svc); err != nil {
+		return nil, err
+	}
+	return svc, nil
+}
+
+func (f *fileStore) List() ([]*models.Service, error) {
+	f.Lock()
+	defer f.Unlock()
+	files, err := ioutil.ReadDir(f.dir)
+	if err != nil {
+		return nil, err
+	}
+	result := []*models.Service{}
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), fileStoreExt) {
+			continue
+		}
+		svc, err := f.read(path.Join(f.dir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, svc)
+	}
+	return result, nil
+}
+
+func (f *fileStore) Get(name string) (*models.Service, error) {
+	f.Lock()
+	defer f.Unlock()
+	svc, err := f.read(f.file(name))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return svc, err
+}
+
+func (f *fileStore) Put(name string, svc *models.Service) error {
+	f.Lock()
+	defer f.Unlock()
+	if err := checkName(name); err != nil {
+		return err
+	}
+	version, err := f.nextVersion()
+	if err != nil {
+		return err
+	}
+	svc.ResourceVersion = version
+	data, err := json.Marshal(svc)
+	if err != nil {
+		return err
+	}
+	return f.write(f.file(name), data)
+}
+
+// write replaces a file of the store with data.
+func (f *fileStore) write(file string, data []byte) error {
+	tmp, err := ioutil.TempFile(path.Dir(file), ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), file); err != nil {
+		return err
+	}
+	return syncDir(path.Dir(file))
+}
+
+// nextVersion makes the next resource version durable before a change uses it.
+func (f *fileStore) nextVersion() (int64, error) {
+	version := f.version + 1
+	if err := f.write(path.Join(f.dir, versionFile), []byte(strconv.FormatInt(version, 10))); err != nil {
+		return 0, err
+	}
+	f.version = version
+	return version, nil
+}
+
+func (f *fileStore) Delete(name string) (*models.Service, error) {
+	f.Lock()
+	defer f.Unlock()
+	svc, err := f.read(f.file(name))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if svc.ResourceVersion, err = f.nextVersion(); err != nil {
+		return nil, err
+	}
+	if err := os.Remove(f.file(name)); err != nil {
+		return nil, err
+	}
+	return svc, syncDir(f.dir)
+}
+
+func (f *fileStore) Version() (int64, error) {
+	f.Lock()
+	defer f.Unlock()
+	return f.version, nil
+}
+
+func (f *fileStore) AddRevision(name string, rev *models.Revision) error {
+	f.Lock()
+	defer f.Unlock()
+	if err := checkName(name); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(f.revisionsDir(name), 0700); err != nil {
+		return err
+	}
+	data, err := json.Marshal(rev)
+	if err != nil {
+		return err
+	}
+	return f.write(f.revisionFile(name, *rev.Revision), data)
+}
+
+func (f *fileStore) readRevision(file string) (*models.Revision, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	rev := &models.Revision{}
+	if err := json.Unmarshal(data, rev); err != nil {
+		return nil, err
+	}
+	return rev, nil
+}
+
+func (f *fileStore) Revisions(name string) ([]*models.Revision, error) {
+	f.Lock()
+	defer f.Unlock()
+	files, err := ioutil.ReadDir(f.revisionsDir(name))
+	if os.IsNotExist(err) {
+		return []*models.Revision{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	result := []*models.Revision{}
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), fileStoreExt) {
+			continue
+		}
+		rev, err := f.readRevision(path.Join(f.revisionsDir(name), file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, rev)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return *result[i].Revision < *result[j].Revision
+	})
+	return result, nil
+}
+
+func (f *fileStore) Revision(name string, revision int64) (*models.Revision, error) {
+	f.Lock()
+	defer f.Unlock()
+	rev, err := f.readRevision(f.revisionFile(name, revision))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return rev, err
+}
+
+// syncDir makes renames and removals in a directory of the store durable.
+func syncDir(name string) error {
+	dir, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
+}
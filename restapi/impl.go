@@ -1,55 +1,208 @@
 package restapi
 
 import (
+	"fmt"
+	"net/http"
+	"strings"
 	"sync"
 
 	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/metaparticle-io/metaparticle-ast/compiler"
 	"github.com/metaparticle-io/metaparticle-ast/models"
 	"github.com/metaparticle-io/metaparticle-ast/restapi/operations/services"
 )
 
 type Impl struct {
 	sync.Mutex
-	services map[string]*models.Service
+	store      Store
+	auth       *Auth
+	audit      AuditLog
+	registries *RegistryOptions
+	// history holds the latest changes to the store, every change after
+	// historySince is in it.
+	history      []*models.WatchEvent
+	historySince int64
+	watchers     map[*watcher]bool
+
+	compilersLock sync.Mutex
+	compilers     map[string]compiler.Compiler
+	deployLock    sync.Mutex
 }
 
-// HandleGetServices implements the GetServiceHandler interface
-func (i *Impl) HandleListServices(params services.ListServicesParams) middleware.Responder {
-	i.Lock()
-	defer i.Unlock()
-	result := []*models.Service{}
-	for _, value := range i.services {
-		result = append(result, value)
+// NewImpl creates the API implementation on top of a store, recording the
+// changes made through it in an audit log. A nil auth allows every request,
+// nil registries let specs use none of the registry credentials of the server.
+func NewImpl(store Store, auth *Auth, audit AuditLog, registries *RegistryOptions) (*Impl, error) {
+	// the changes made before the server started can't be watched
+	version, err := store.Version()
+	if err != nil {
+		return nil, err
+	}
+	return &Impl{
+		store:        store,
+		auth:         auth,
+		audit:        audit,
+		registries:   registries,
+		historySince: version,
+		watchers:     map[*watcher]bool{},
+		compilers:    map[string]compiler.Compiler{},
+	}, nil
+}
+
+// apiError creates the error payload for a failed request.
+func apiError(code int, message string) *models.Error {
+	return &models.Error{Code: int64(code), Message: swag.String(message)}
+}
+
+// etag returns the entity tag of a stored service, its quoted resource version.
+func etag(svc *models.Service) string {
+	return fmt.Sprintf("\"%d\"", svc.ResourceVersion)
+}
+
+// ifMatch reports whether an If-Match header matches a service, which is nil
+// when it doesn't exist.
+func ifMatch(header string, svc *models.Service) bool {
+	if svc == nil {
+		return false
+	}
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || tag == etag(svc) {
+			return true
+		}
+	}
+	return false
+}
+
+// preconditionFailed explains why an If-Match header doesn't match a service.
+func preconditionFailed(name string, svc *models.Service) *models.Error {
+	message := "service " + name + " doesn't exist"
+	if svc != nil {
+		message = fmt.Sprintf("service %s has changed, its ETag is %s", name, etag(svc))
 	}
-	return services.NewGetServicesOK().WithPayload(result)
+	return apiError(http.StatusPreconditionFailed, message)
 }
 
 // HandleDestroyOne implements the DestroyOneHanlder interface
 func (i *Impl) HandleDestroyOne(param services.DeleteServiceParams) middleware.Responder {
+	entry := newAuditEntry(param.HTTPRequest, models.AuditEntryOperationDelete, param.Name)
+	return i.audited(entry, func() middleware.Responder {
+		return i.destroyOne(param, entry)
+	})
+}
+
+func (i *Impl) destroyOne(param services.DeleteServiceParams, entry *models.AuditEntry) middleware.Responder {
+	if !i.auth.Allowed(param.HTTPRequest, RoleAdmin, param.Name) {
+		return services.NewDeleteServiceDefault(http.StatusForbidden).WithPayload(forbidden(param.HTTPRequest, RoleAdmin, param.Name))
+	}
 	i.Lock()
 	defer i.Unlock()
-	delete(i.services, param.Name)
+	if param.IfMatch != nil {
+		existing, err := i.store.Get(param.Name)
+		if err != nil {
+			return services.NewDeleteServiceDefault(http.StatusInternalServerError).WithPayload(apiError(http.StatusInternalServerError, err.Error()))
+		}
+		if !ifMatch(*param.IfMatch, existing) {
+			return services.NewDeleteServicePreconditionFailed().WithPayload(preconditionFailed(param.Name, existing))
+		}
+	}
+	deleted, err := i.store.Delete(param.Name)
+	if err != nil {
+		return services.NewDeleteServiceDefault(http.StatusInternalServerError).WithPayload(apiError(http.StatusInternalServerError, err.Error()))
+	}
+	if deleted != nil {
+		entry.OldDigest = specDigest(deleted)
+		i.publish(models.WatchEventTypeDELETED, deleted)
+	}
 	return services.NewDeleteServiceNoContent()
 }
 
 // HandleGetOne implements the GetOneHandler interface
 func (i *Impl) HandleGetOne(param services.GetServiceParams) middleware.Responder {
+	if !i.auth.Allowed(param.HTTPRequest, RoleReader, param.Name) {
+		return services.NewGetServiceDefault(http.StatusForbidden).WithPayload(forbidden(param.HTTPRequest, RoleReader, param.Name))
+	}
 	i.Lock()
 	defer i.Unlock()
-	service, found := i.services[param.Name]
-	if !found || service == nil {
-		return services.NewGetServiceDefault(404).WithPayload(&models.Error{Code: 404})
+	service, err := i.store.Get(param.Name)
+	if err != nil {
+		return services.NewGetServiceDefault(http.StatusInternalServerError).WithPayload(apiError(http.StatusInternalServerError, err.Error()))
 	}
-	return services.NewGetServiceOK().WithPayload(service)
+	if service == nil {
+		return services.NewGetServiceDefault(http.StatusNotFound).WithPayload(apiError(http.StatusNotFound, "service "+param.Name+" not found"))
+	}
+	return services.NewGetServiceOK().WithETag(etag(service)).WithPayload(service)
 }
 
 // HandlUpdateOne implements the UpdateOneHandler interface
 func (i *Impl) HandleUpdateOne(param services.CreateOrUpdateServiceParams) middleware.Responder {
+	entry := newAuditEntry(param.HTTPRequest, models.AuditEntryOperationUpdate, param.Name)
+	return i.audited(entry, func() middleware.Responder {
+		return i.updateOne(param, entry)
+	})
+}
+
+func (i *Impl) updateOne(param services.CreateOrUpdateServiceParams, entry *models.AuditEntry) middleware.Responder {
+	if !i.auth.Allowed(param.HTTPRequest, RoleDeployer, param.Name) {
+		return services.NewCreateOrUpdateServiceDefault(http.StatusForbidden).WithPayload(forbidden(param.HTTPRequest, RoleDeployer, param.Name))
+	}
+	if param.Body == nil {
+		return services.NewCreateOrUpdateServiceBadRequest().WithPayload(apiError(http.StatusBadRequest, "a service is required in the request body"))
+	}
+	if *param.Body.Name != param.Name {
+		message := fmt.Sprintf("the service is named %s but the path names %s", *param.Body.Name, param.Name)
+		return services.NewCreateOrUpdateServiceBadRequest().WithPayload(apiError(http.StatusBadRequest, message))
+	}
+	if err := compiler.Validate(param.Body); err != nil {
+		return services.NewCreateOrUpdateServiceUnprocessableEntity().WithPayload(apiError(http.StatusUnprocessableEntity, err.Error()))
+	}
+	if err := i.registries.checkRegistries(param.Body); err != nil {
+		return services.NewCreateOrUpdateServiceUnprocessableEntity().WithPayload(apiError(http.StatusUnprocessableEntity, err.Error()))
+	}
 	i.Lock()
 	defer i.Unlock()
-	if i.services == nil {
-		i.services = map[string]*models.Service{}
+	existing, err := i.store.Get(param.Name)
+	if err != nil {
+		return services.NewCreateOrUpdateServiceDefault(http.StatusInternalServerError).WithPayload(apiError(http.StatusInternalServerError, err.Error()))
+	}
+	if existing == nil {
+		entry.Operation = swag.String(models.AuditEntryOperationCreate)
+	}
+	entry.OldDigest = specDigest(existing)
+	if param.IfMatch != nil && !ifMatch(*param.IfMatch, existing) {
+		return services.NewCreateOrUpdateServicePreconditionFailed().WithPayload(preconditionFailed(param.Name, existing))
+	}
+	if version := param.Body.ResourceVersion; version != 0 {
+		if existing == nil {
+			message := fmt.Sprintf("service %s doesn't exist, so it has no resource version %d", param.Name, version)
+			return services.NewCreateOrUpdateServiceConflict().WithPayload(apiError(http.StatusConflict, message))
+		}
+		if existing.ResourceVersion != version {
+			message := fmt.Sprintf("service %s is at resource version %d, not %d", param.Name, existing.ResourceVersion, version)
+			return services.NewCreateOrUpdateServiceConflict().WithPayload(apiError(http.StatusConflict, message))
+		}
+	}
+	// only the server knows where a service is deployed
+	param.Body.DeployedBackend = ""
+	if existing != nil {
+		param.Body.DeployedBackend = existing.DeployedBackend
+	}
+	summary := swag.StringValue(param.Summary)
+	if len(summary) == 0 {
+		summary = "updated"
+		if existing == nil {
+			summary = "created"
+		}
+	}
+	if err := i.storeRevision(param.Body, author(param.HTTPRequest, param.Author), summary); err != nil {
+		return services.NewCreateOrUpdateServiceDefault(http.StatusInternalServerError).WithPayload(apiError(http.StatusInternalServerError, err.Error()))
+	}
+	entry.NewDigest = specDigest(param.Body)
+	if existing == nil {
+		i.publish(models.WatchEventTypeADDED, param.Body)
+	} else {
+		i.publish(models.WatchEventTypeMODIFIED, param.Body)
 	}
-	i.services[param.Name] = param.Body
-	return services.NewCreateOrUpdateServiceOK().WithPayload(param.Body)
+	return services.NewCreateOrUpdateServiceOK().WithETag(etag(param.Body)).WithPayload(param.Body)
 }
@@ -0,0 +1,155 @@
+package restapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	runtime "github.com/go-openapi/runtime"
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+	"github.com/metaparticle-io/metaparticle-ast/restapi/operations/services"
+)
+
+// newTestImpl creates an Impl on a memory store and audit log that allows every request.
+func newTestImpl(t *testing.T, registries *RegistryOptions) *Impl {
+	t.Helper()
+	impl, err := NewImpl(NewMemoryStore(), nil, NewMemoryAuditLog(), registries)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return impl
+}
+
+// respond writes the response of a handler.
+func respond(responder middleware.Responder) *httptest.ResponseRecorder {
+	rw := httptest.NewRecorder()
+	responder.WriteResponse(rw, runtime.JSONProducer())
+	return rw
+}
+
+// parseSpec parses a service spec, failing the test if it is invalid JSON.
+func parseSpec(t *testing.T, spec string) *models.Service {
+	t.Helper()
+	svc := &models.Service{}
+	if err := json.Unmarshal([]byte(spec), svc); err != nil {
+		t.Fatalf("invalid spec: %v", err)
+	}
+	return svc
+}
+
+// put sends a PUT of a spec with an optional If-Match header.
+func put(t *testing.T, impl *Impl, name string, spec string, match string) *httptest.ResponseRecorder {
+	t.Helper()
+	params := services.CreateOrUpdateServiceParams{
+		HTTPRequest: httptest.NewRequest(http.MethodPut, "/services/"+name, nil),
+		Name:        name,
+		Body:        parseSpec(t, spec),
+	}
+	if len(match) > 0 {
+		params.IfMatch = &match
+	}
+	return respond(impl.HandleUpdateOne(params))
+}
+
+func TestIfMatch(t *testing.T) {
+	svc := &models.Service{ResourceVersion: 7}
+	tests := []struct {
+		header  string
+		svc     *models.Service
+		matches bool
+	}{
+		{header: `"7"`, svc: svc, matches: true},
+		{header: `"6", "7"`, svc: svc, matches: true},
+		{header: `*`, svc: svc, matches: true},
+		{header: `"6"`, svc: svc},
+		{header: `7`, svc: svc},
+		{header: `*`},
+	}
+	for _, test := range tests {
+		if matches := ifMatch(test.header, test.svc); matches != test.matches {
+			t.Errorf("ifMatch(%s, %v) = %v, expected %v", test.header, test.svc, matches, test.matches)
+		}
+	}
+}
+
+func TestOptimisticConcurrency(t *testing.T) {
+	tests := []struct {
+		name  string
+		spec  string
+		match string
+		code  int
+		etag  string
+	}{
+		{name: "unconditional", spec: `{"name": "web"}`, code: http.StatusOK, etag: `"2"`},
+		{name: "matching etag", spec: `{"name": "web"}`, match: `"1"`, code: http.StatusOK, etag: `"2"`},
+		{name: "stale etag", spec: `{"name": "web"}`, match: `"0"`, code: http.StatusPreconditionFailed},
+		{name: "matching version", spec: `{"name": "web", "resourceVersion": 1}`, code: http.StatusOK, etag: `"2"`},
+		{name: "stale version", spec: `{"name": "web", "resourceVersion": 3}`, code: http.StatusConflict},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			impl := newTestImpl(t, nil)
+			if rw := put(t, impl, "web", `{"name": "web"}`, ""); rw.Code != http.StatusOK || rw.Header().Get("ETag") != `"1"` {
+				t.Fatalf("unexpected response to the create: %d %v", rw.Code, rw.Header())
+			}
+			rw := put(t, impl, "web", test.spec, test.match)
+			if rw.Code != test.code {
+				t.Fatalf("expected %d, got %d: %s", test.code, rw.Code, rw.Body.String())
+			}
+			if etag := rw.Header().Get("ETag"); etag != test.etag {
+				t.Errorf("expected ETag %q, got %q", test.etag, etag)
+			}
+		})
+	}
+
+	impl := newTestImpl(t, nil)
+	if rw := put(t, impl, "web", `{"name": "web"}`, `*`); rw.Code != http.StatusPreconditionFailed {
+		t.Errorf("expected If-Match to fail for a missing service, got %d", rw.Code)
+	}
+	if rw := put(t, impl, "web", `{"name": "web", "resourceVersion": 1}`, ""); rw.Code != http.StatusConflict {
+		t.Errorf("expected a resource version to conflict for a missing service, got %d", rw.Code)
+	}
+	put(t, impl, "web", `{"name": "web"}`, "")
+	for _, test := range []struct {
+		match string
+		code  int
+	}{
+		{match: `"2"`, code: http.StatusPreconditionFailed},
+		{match: `"1"`, code: http.StatusNoContent},
+	} {
+		match := test.match
+		rw := respond(impl.HandleDestroyOne(services.DeleteServiceParams{
+			HTTPRequest: httptest.NewRequest(http.MethodDelete, "/services/web", nil),
+			Name:        "web",
+			IfMatch:     &match,
+		}))
+		if rw.Code != test.code {
+			t.Errorf("DELETE with If-Match %s: expected %d, got %d", test.match, test.code, rw.Code)
+		}
+	}
+}
+
+func TestUpdateNullEntries(t *testing.T) {
+	specs := []string{
+		`{"name": "app", "services": [{"name": "web", "containers": [null]}]}`,
+		`{"name": "app", "registries": [null]}`,
+		`{"name": "app", "services": [{"name": "web", "placement": {"tolerations": [null]}}]}`,
+		`{"name": "app", "services": [{"name": "web", "placement": {"podAffinity": [null]}}]}`,
+		`{"name": "app", "services": [{"name": "web"}], "serve": {"name": "web", "paths": [null]}}`,
+		`{"name": "app", "services": [{"name": "web", "ports": [null], "shardSpec": {"shards": 2}}]}`,
+		`{"name": "app", "services": [{"name": "web", "replicas": 2, "ports": [null]}]}`,
+		`{"name": "app", "services": [{"name": "web"}], "serves": [null]}`,
+		`{"name": "app", "services": [{"name": "web", "containers": [{"image": "web", "env": [null]}]}]}`,
+	}
+	for _, spec := range specs {
+		impl := newTestImpl(t, nil)
+		if rw := put(t, impl, "app", spec, ""); rw.Code != http.StatusUnprocessableEntity {
+			t.Errorf("%s: expected %d, got %d: %s", spec, http.StatusUnprocessableEntity, rw.Code, rw.Body.String())
+		}
+		if stored, _ := impl.store.Get("app"); stored != nil {
+			t.Errorf("%s: expected nothing to be stored", spec)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package restapi
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+	"github.com/metaparticle-io/metaparticle-ast/restapi/operations/services"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// serviceFilter selects the services that a list or watch returns.
+type serviceFilter struct {
+	selector labels.Selector
+	prefix   string
+	backend  *string
+	deployed *bool
+}
+
+func newServiceFilter(params services.ListServicesParams) (*serviceFilter, error) {
+	filter := &serviceFilter{
+		selector: labels.Everything(),
+		backend:  params.Backend,
+		deployed: params.Deployed,
+	}
+	if params.LabelSelector != nil {
+		selector, err := labels.Parse(*params.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label selector: %v", err)
+		}
+		filter.selector = selector
+	}
+	if params.Prefix != nil {
+		filter.prefix = *params.Prefix
+	}
+	return filter, nil
+}
+
+func (f *serviceFilter) matches(svc *models.Service) bool {
+	if !strings.HasPrefix(*svc.Name, f.prefix) {
+		return false
+	}
+	if !f.selector.Matches(labels.Set(svc.Labels)) {
+		return false
+	}
+	if f.backend != nil && svc.DeployedBackend != *f.backend {
+		return false
+	}
+	if f.deployed != nil && (len(svc.DeployedBackend) > 0) != *f.deployed {
+		return false
+	}
+	return true
+}
+
+// continueToken returns the token of the page after the named service.
+func continueToken(name string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(name))
+}
+
+// parseContinueToken returns the name of the last service of the previous page.
+func parseContinueToken(token string) (string, error) {
+	name, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", fmt.Errorf("invalid continue token")
+	}
+	return string(name), nil
+}
+
+// HandleListServices implements the ListServicesHandler interface
+func (i *Impl) HandleListServices(params services.ListServicesParams) middleware.Responder {
+	filter, err := newServiceFilter(params)
+	if err != nil {
+		return services.NewListServicesBadRequest().WithPayload(apiError(http.StatusBadRequest, err.Error()))
+	}
+	if params.Watch != nil && *params.Watch {
+		return i.watch(params, filter)
+	}
+	after := ""
+	if params.Continue != nil {
+		if after, err = parseContinueToken(*params.Continue); err != nil {
+			return services.NewListServicesBadRequest().WithPayload(apiError(http.StatusBadRequest, err.Error()))
+		}
+	}
+
+	i.Lock()
+	stored, err := i.store.List()
+	i.Unlock()
+	if err != nil {
+		return services.NewListServicesDefault(http.StatusInternalServerError).WithPayload(apiError(http.StatusInternalServerError, err.Error()))
+	}
+	// pages follow the names, so services added or removed between pages
+	// don't move the others
+	sort.Slice(stored, func(a, b int) bool {
+		return *stored[a].Name < *stored[b].Name
+	})
+	result := []*models.Service{}
+	next := ""
+	for _, svc := range stored {
+		if *svc.Name <= after && params.Continue != nil {
+			continue
+		}
+		if !i.auth.Allowed(params.HTTPRequest, RoleReader, *svc.Name) || !filter.matches(svc) {
+			continue
+		}
+		if params.Limit != nil && len(result) == int(*params.Limit) {
+			next = continueToken(*result[len(result)-1].Name)
+			break
+		}
+		result = append(result, svc)
+	}
+	return services.NewListServicesOK().WithXContinue(next).WithPayload(result)
+}
@@ -0,0 +1,166 @@
+package restapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/metaparticle-io/metaparticle-ast/restapi/operations/services"
+)
+
+// listImpl returns an Impl storing services a to e, of which b and d are
+// deployed to docker and c and d are labelled tier=backend.
+func listImpl(t *testing.T) *Impl {
+	t.Helper()
+	impl := newTestImpl(t, nil)
+	for _, name := range []string{"e", "c", "a", "d", "b"} {
+		svc := newService(name)
+		if name == "b" || name == "d" {
+			svc.DeployedBackend = "docker"
+		}
+		if name == "c" || name == "d" {
+			svc.Labels = map[string]string{"tier": "backend"}
+		}
+		if err := impl.store.Put(name, svc); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	return impl
+}
+
+// list returns the names and continue token of a page of services.
+func list(t *testing.T, impl *Impl, params services.ListServicesParams) ([]string, string) {
+	t.Helper()
+	if params.HTTPRequest == nil {
+		params.HTTPRequest = httptest.NewRequest(http.MethodGet, "/services", nil)
+	}
+	ok, isOK := impl.HandleListServices(params).(*services.ListServicesOK)
+	if !isOK {
+		t.Fatalf("expected a list of services")
+	}
+	names := []string{}
+	for _, svc := range ok.Payload {
+		names = append(names, *svc.Name)
+	}
+	return names, ok.XContinue
+}
+
+func TestContinueToken(t *testing.T) {
+	for _, name := range []string{"", "web", "a/b+c=d", "ünïcode"} {
+		parsed, err := parseContinueToken(continueToken(name))
+		if err != nil || parsed != name {
+			t.Errorf("expected %q back, got %q, %v", name, parsed, err)
+		}
+	}
+	if _, err := parseContinueToken("not base64!"); err == nil {
+		t.Errorf("expected an invalid token to be refused")
+	}
+}
+
+func TestListPages(t *testing.T) {
+	tests := []struct {
+		name          string
+		limit         int32
+		labelSelector *string
+		deployed      *bool
+		pages         [][]string
+	}{
+		{name: "no limit", pages: [][]string{{"a", "b", "c", "d", "e"}}},
+		{name: "pages of 2", limit: 2, pages: [][]string{{"a", "b"}, {"c", "d"}, {"e"}}},
+		{name: "a page per service", limit: 1, pages: [][]string{{"a"}, {"b"}, {"c"}, {"d"}, {"e"}}},
+		{name: "exact fit", limit: 5, pages: [][]string{{"a", "b", "c", "d", "e"}}},
+		{name: "selector", limit: 1, labelSelector: swag.String("tier=backend"), pages: [][]string{{"c"}, {"d"}}},
+		{name: "deployed", limit: 1, deployed: swag.Bool(true), pages: [][]string{{"b"}, {"d"}}},
+		{name: "not deployed", limit: 2, deployed: swag.Bool(false), pages: [][]string{{"a", "c"}, {"e"}}},
+		{name: "nothing matches", limit: 2, labelSelector: swag.String("tier=frontend"), pages: [][]string{{}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			impl := listImpl(t)
+			params := services.ListServicesParams{LabelSelector: test.labelSelector, Deployed: test.deployed}
+			if test.limit > 0 {
+				params.Limit = swag.Int32(test.limit)
+			}
+			pages := [][]string{}
+			for {
+				names, next := list(t, impl, params)
+				pages = append(pages, names)
+				if len(next) == 0 {
+					break
+				}
+				if len(pages) > 10 {
+					t.Fatalf("too many pages: %v", pages)
+				}
+				params.Continue = swag.String(next)
+			}
+			if !reflect.DeepEqual(pages, test.pages) {
+				t.Errorf("expected %v, got %v", test.pages, pages)
+			}
+		})
+	}
+}
+
+func TestListChangesBetweenPages(t *testing.T) {
+	impl := listImpl(t)
+	params := services.ListServicesParams{Limit: swag.Int32(2)}
+	names, next := list(t, impl, params)
+	if !reflect.DeepEqual(names, []string{"a", "b"}) {
+		t.Fatalf("unexpected first page %v", names)
+	}
+	// removing the last service of a page and adding one before it doesn't
+	// move the next page
+	if _, err := impl.store.Delete("b"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := impl.store.Put("aa", newService("aa")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	params.Continue = swag.String(next)
+	if names, _ := list(t, impl, params); !reflect.DeepEqual(names, []string{"c", "d"}) {
+		t.Errorf("expected [c d], got %v", names)
+	}
+}
+
+func TestListBadRequests(t *testing.T) {
+	tests := []struct {
+		name   string
+		params services.ListServicesParams
+	}{
+		{name: "bad selector", params: services.ListServicesParams{LabelSelector: swag.String("tier in (")}},
+		{name: "bad continue token", params: services.ListServicesParams{Continue: swag.String("not base64!")}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			impl := listImpl(t)
+			test.params.HTTPRequest = httptest.NewRequest(http.MethodGet, "/services", nil)
+			rw := respond(impl.HandleListServices(test.params))
+			if rw.Code != http.StatusBadRequest {
+				t.Errorf("expected %d, got %d: %s", http.StatusBadRequest, rw.Code, rw.Body.String())
+			}
+		})
+	}
+}
+
+func TestListAllowed(t *testing.T) {
+	impl := listImpl(t)
+	impl.auth = &Auth{grants: map[string][]grant{"bob": {{RoleReader, []string{"b", "d"}}}}}
+	params := services.ListServicesParams{
+		HTTPRequest: authAs(httptest.NewRequest(http.MethodGet, "/services", nil), "bob"),
+		Limit:       swag.Int32(1),
+	}
+	names, next := list(t, impl, params)
+	if !reflect.DeepEqual(names, []string{"b"}) || len(next) == 0 {
+		t.Fatalf("expected [b] and a next page, got %v, %q", names, next)
+	}
+	params.Continue = swag.String(next)
+	if names, next := list(t, impl, params); !reflect.DeepEqual(names, []string{"d"}) || len(next) != 0 {
+		t.Errorf("expected [d] and no next page, got %v, %q", names, next)
+	}
+
+	impl.auth = &Auth{}
+	if names, _ := list(t, impl, services.ListServicesParams{}); len(names) != 0 {
+		t.Errorf("expected a user without roles to see nothing, got %v", names)
+	}
+}
@@ -0,0 +1,57 @@
+package restapi
+
+import (
+	"net/http"
+	"time"
+
+	runtime "github.com/go-openapi/runtime"
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/metaparticle-io/metaparticle-ast/compiler"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+	"github.com/metaparticle-io/metaparticle-ast/restapi/operations/services"
+)
+
+// HandleLogs implements the GetServiceLogsHandler interface
+func (i *Impl) HandleLogs(params services.GetServiceLogsParams) middleware.Responder {
+	if !i.auth.Allowed(params.HTTPRequest, RoleReader, params.Name) {
+		return services.NewGetServiceLogsDefault(http.StatusForbidden).WithPayload(forbidden(params.HTTPRequest, RoleReader, params.Name))
+	}
+	i.Lock()
+	svc, err := i.store.Get(params.Name)
+	i.Unlock()
+	if err != nil {
+		return services.NewGetServiceLogsDefault(http.StatusInternalServerError).WithPayload(apiError(http.StatusInternalServerError, err.Error()))
+	}
+	if svc == nil {
+		return services.NewGetServiceLogsNotFound().WithPayload(apiError(http.StatusNotFound, "service "+params.Name+" not found"))
+	}
+	backend := defaultBackend
+	if params.Backend != nil {
+		backend = *params.Backend
+	}
+	cmp, err := i.compilerFor(backend)
+	if err != nil {
+		return services.NewGetServiceLogsDefault(http.StatusInternalServerError).WithPayload(apiError(http.StatusInternalServerError, err.Error()))
+	}
+
+	opts := &compiler.LogOptions{
+		Follow: params.Follow != nil && *params.Follow,
+	}
+	if params.Container != nil {
+		opts.Container = *params.Container
+	}
+	if params.Since != nil {
+		opts.Since = time.Time(*params.Since)
+	}
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		w := newStreamWriter(rw, params.HTTPRequest)
+		// the stream stops when the client goes away
+		err := cmp.StreamLogs(svc, opts, params.HTTPRequest.Context().Done(), func(event *models.LogEvent) {
+			w.write("log", event)
+		})
+		if err != nil {
+			// the status has been sent, so the error ends the stream instead
+			w.write("error", apiError(http.StatusBadGateway, err.Error()))
+		}
+	})
+}
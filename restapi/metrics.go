@@ -0,0 +1,188 @@
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// metricsPath is where Prometheus scrapes the metrics of the server. It is
+// served before authentication.
+const metricsPath = "/metrics"
+
+var (
+	requestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "metaparticle_http_requests_total",
+		Help: "The requests handled, by operation, method and status code.",
+	}, []string{"operation", "method", "code"})
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "metaparticle_http_request_duration_seconds",
+		Help:    "How long requests took to handle, by operation and method.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"operation", "method"})
+	deploymentsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "metaparticle_deployments_total",
+		Help: "The plans run for deploys and undeploys, by backend, operation and result. Dry runs aren't counted.",
+	}, []string{"backend", "operation", "result"})
+	auditFailuresTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "metaparticle_audit_failures_total",
+		Help: "The entries that couldn't be appended to the audit log, each failed the request it was for.",
+	})
+
+	// requestLog writes a line of JSON for every request.
+	requestLog = log.New(os.Stderr, "", 0)
+)
+
+func init() {
+	prometheus.MustRegister(requestsTotal, requestDuration, deploymentsTotal, auditFailuresTotal)
+}
+
+// servicesGauge reports how many services are stored.
+func (i *Impl) servicesGauge() prometheus.Collector {
+	return prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "metaparticle_services",
+		Help: "The number of stored services.",
+	}, func() float64 {
+		i.Lock()
+		defer i.Unlock()
+		stored, err := i.store.List()
+		if err != nil {
+			return 0
+		}
+		return float64(len(stored))
+	})
+}
+
+// countDeployment counts a plan that was run.
+func countDeployment(backend string, undeploy bool, err error) {
+	operation := "deploy"
+	if undeploy {
+		operation = "undeploy"
+	}
+	result := "succeeded"
+	if err != nil {
+		result = "failed"
+	}
+	deploymentsTotal.WithLabelValues(backend, operation, result).Inc()
+}
+
+// requestInfo is what the handlers learn about a request that its log line
+// and metrics need.
+type requestInfo struct {
+	operation string
+	route     string
+	user      string
+}
+
+type requestInfoKey struct{}
+
+func requestInfoFrom(r *http.Request) *requestInfo {
+	info, _ := r.Context().Value(requestInfoKey{}).(*requestInfo)
+	return info
+}
+
+// setRequestUser notes the authenticated user of a request.
+func setRequestUser(r *http.Request, user string) {
+	if info := requestInfoFrom(r); info != nil {
+		info.user = user
+	}
+}
+
+// recordRoute notes the operation a request was routed to.
+func recordRoute(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		route := middleware.MatchedRouteFrom(r)
+		if info := requestInfoFrom(r); info != nil && route != nil && route.Operation != nil {
+			info.operation = route.Operation.ID
+			info.route = route.PathPattern
+		}
+		handler.ServeHTTP(rw, r)
+	})
+}
+
+// statusWriter remembers the status and size of a response.
+type statusWriter struct {
+	http.ResponseWriter
+	code  int
+	bytes int
+}
+
+func (s *statusWriter) WriteHeader(code int) {
+	s.code = code
+	s.ResponseWriter.WriteHeader(code)
+}
+
+func (s *statusWriter) Write(data []byte) (int, error) {
+	n, err := s.ResponseWriter.Write(data)
+	s.bytes += n
+	return n, err
+}
+
+// Flush lets logs and watches stream through the writer.
+func (s *statusWriter) Flush() {
+	if flusher, ok := s.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// requestLine is the log line of a request.
+type requestLine struct {
+	Time       string  `json:"time"`
+	Method     string  `json:"method"`
+	Path       string  `json:"path"`
+	Route      string  `json:"route,omitempty"`
+	Operation  string  `json:"operation,omitempty"`
+	Status     int     `json:"status"`
+	Latency    float64 `json:"latencySeconds"`
+	Bytes      int     `json:"bytes"`
+	User       string  `json:"user,omitempty"`
+	RemoteAddr string  `json:"remoteAddr"`
+}
+
+// instrument serves the metrics, and logs and measures every other request.
+func instrument(handler http.Handler) http.Handler {
+	metrics := promhttp.Handler()
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == metricsPath {
+			metrics.ServeHTTP(rw, r)
+			return
+		}
+		start := time.Now()
+		info := &requestInfo{}
+		w := &statusWriter{ResponseWriter: rw, code: http.StatusOK}
+		handler.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestInfoKey{}, info)))
+		latency := time.Since(start)
+
+		// requests that weren't routed, like the spec or unknown paths
+		operation := info.operation
+		if len(operation) == 0 {
+			operation = "none"
+		}
+		requestsTotal.WithLabelValues(operation, r.Method, strconv.Itoa(w.code)).Inc()
+		requestDuration.WithLabelValues(operation, r.Method).Observe(latency.Seconds())
+
+		line, err := json.Marshal(&requestLine{
+			Time:       start.UTC().Format(time.RFC3339Nano),
+			Method:     r.Method,
+			Path:       r.URL.Path,
+			Route:      info.route,
+			Operation:  info.operation,
+			Status:     w.code,
+			Latency:    latency.Seconds(),
+			Bytes:      w.bytes,
+			User:       info.user,
+			RemoteAddr: r.RemoteAddr,
+		})
+		if err == nil {
+			requestLog.Println(string(line))
+		}
+	})
+}
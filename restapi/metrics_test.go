@@ -0,0 +1,132 @@
+package restapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+// logRequests sends the request log to a buffer until the test ends.
+func logRequests(t *testing.T) *bytes.Buffer {
+	out := &bytes.Buffer{}
+	requestLog.SetOutput(out)
+	t.Cleanup(func() {
+		requestLog.SetOutput(os.Stderr)
+	})
+	return out
+}
+
+func TestInstrument(t *testing.T) {
+	tests := []struct {
+		name      string
+		path      string
+		operation string
+		user      string
+		code      int
+		body      string
+		label     string
+	}{
+		{name: "routed", path: "/services/web", operation: "getService", user: "alice", code: http.StatusOK, body: "{}", label: "getService"},
+		{name: "failed", path: "/services/web", operation: "deleteService", code: http.StatusNotFound, label: "deleteService"},
+		{name: "not routed", path: "/swagger.json", code: http.StatusOK, body: "{}", label: "none"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out := logRequests(t)
+			handler := instrument(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				if len(test.operation) > 0 {
+					info := requestInfoFrom(r)
+					info.operation = test.operation
+					info.route = "/services/{name}"
+				}
+				setRequestUser(r, test.user)
+				if test.code != http.StatusOK {
+					rw.WriteHeader(test.code)
+				}
+				fmt.Fprint(rw, test.body)
+			}))
+			counter := requestsTotal.WithLabelValues(test.label, http.MethodGet, fmt.Sprint(test.code))
+			before := testutil.ToFloat64(counter)
+
+			rw := httptest.NewRecorder()
+			handler.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, test.path, nil))
+			if rw.Code != test.code || rw.Body.String() != test.body {
+				t.Errorf("expected the response to go through, got %d %q", rw.Code, rw.Body.String())
+			}
+			if testutil.ToFloat64(counter) != before+1 {
+				t.Errorf("expected the request to be counted as %s %d", test.label, test.code)
+			}
+
+			line := &requestLine{}
+			if err := json.Unmarshal(out.Bytes(), line); err != nil {
+				t.Fatalf("invalid log line %q: %v", out.String(), err)
+			}
+			if line.Path != test.path || line.Operation != test.operation || line.User != test.user || line.Status != test.code || line.Bytes != len(test.body) {
+				t.Errorf("unexpected log line %+v", line)
+			}
+		})
+	}
+}
+
+func TestInstrumentMetrics(t *testing.T) {
+	out := logRequests(t)
+	countDeployment("docker", false, nil)
+	handler := instrument(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		t.Errorf("expected the metrics to be served before the handler")
+	}))
+	rw := httptest.NewRecorder()
+	handler.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, metricsPath, nil))
+	if rw.Code != http.StatusOK {
+		t.Fatalf("expected %d, got %d", http.StatusOK, rw.Code)
+	}
+	for _, name := range []string{"metaparticle_deployments_total", "metaparticle_audit_failures_total"} {
+		if !strings.Contains(rw.Body.String(), name) {
+			t.Errorf("expected %s in the metrics", name)
+		}
+	}
+	if out.Len() > 0 {
+		t.Errorf("expected scrapes not to be logged, got %q", out.String())
+	}
+}
+
+func TestCountDeployment(t *testing.T) {
+	tests := []struct {
+		undeploy  bool
+		err       error
+		operation string
+		result    string
+	}{
+		{operation: "deploy", result: "succeeded"},
+		{undeploy: true, operation: "undeploy", result: "succeeded"},
+		{err: fmt.Errorf("failed"), operation: "deploy", result: "failed"},
+		{undeploy: true, err: fmt.Errorf("failed"), operation: "undeploy", result: "failed"},
+	}
+	for _, test := range tests {
+		counter := deploymentsTotal.WithLabelValues("aci", test.operation, test.result)
+		before := testutil.ToFloat64(counter)
+		countDeployment("aci", test.undeploy, test.err)
+		if testutil.ToFloat64(counter) != before+1 {
+			t.Errorf("expected a %s that %s to be counted", test.operation, test.result)
+		}
+	}
+}
+
+func TestServicesGauge(t *testing.T) {
+	impl := newTestImpl(t, nil)
+	gauge := impl.servicesGauge()
+	if count := testutil.ToFloat64(gauge); count != 0 {
+		t.Errorf("expected 0 services, got %v", count)
+	}
+	put(t, impl, "web", `{"name": "web"}`, "")
+	put(t, impl, "api", `{"name": "api"}`, "")
+	if count := testutil.ToFloat64(gauge); count != 2 {
+		t.Errorf("expected 2 services, got %v", count)
+	}
+}
@@ -40,15 +40,42 @@ func NewAnApplicationForEasierDistributedApplicationGenerationAPI(spec *loads.Do
 		ServicesCreateOrUpdateServiceHandler: services.CreateOrUpdateServiceHandlerFunc(func(params services.CreateOrUpdateServiceParams) middleware.Responder {
 			return middleware.NotImplemented("operation ServicesCreateOrUpdateService has not yet been implemented")
 		}),
+		ServicesCreatePlanHandler: services.CreatePlanHandlerFunc(func(params services.CreatePlanParams) middleware.Responder {
+			return middleware.NotImplemented("operation ServicesCreatePlan has not yet been implemented")
+		}),
 		ServicesDeleteServiceHandler: services.DeleteServiceHandlerFunc(func(params services.DeleteServiceParams) middleware.Responder {
 			return middleware.NotImplemented("operation ServicesDeleteService has not yet been implemented")
 		}),
+		ServicesDeployServiceHandler: services.DeployServiceHandlerFunc(func(params services.DeployServiceParams) middleware.Responder {
+			return middleware.NotImplemented("operation ServicesDeployService has not yet been implemented")
+		}),
 		ServicesGetServiceHandler: services.GetServiceHandlerFunc(func(params services.GetServiceParams) middleware.Responder {
 			return middleware.NotImplemented("operation ServicesGetService has not yet been implemented")
 		}),
+		ServicesGetServiceLogsHandler: services.GetServiceLogsHandlerFunc(func(params services.GetServiceLogsParams) middleware.Responder {
+			return middleware.NotImplemented("operation ServicesGetServiceLogs has not yet been implemented")
+		}),
+		ServicesGetServiceRevisionHandler: services.GetServiceRevisionHandlerFunc(func(params services.GetServiceRevisionParams) middleware.Responder {
+			return middleware.NotImplemented("operation ServicesGetServiceRevision has not yet been implemented")
+		}),
+		ServicesGetServiceStatusHandler: services.GetServiceStatusHandlerFunc(func(params services.GetServiceStatusParams) middleware.Responder {
+			return middleware.NotImplemented("operation ServicesGetServiceStatus has not yet been implemented")
+		}),
+		ServicesListAuditEntriesHandler: services.ListAuditEntriesHandlerFunc(func(params services.ListAuditEntriesParams) middleware.Responder {
+			return middleware.NotImplemented("operation ServicesListAuditEntries has not yet been implemented")
+		}),
+		ServicesListServiceRevisionsHandler: services.ListServiceRevisionsHandlerFunc(func(params services.ListServiceRevisionsParams) middleware.Responder {
+			return middleware.NotImplemented("operation ServicesListServiceRevisions has not yet been implemented")
+		}),
 		ServicesListServicesHandler: services.ListServicesHandlerFunc(func(params services.ListServicesParams) middleware.Responder {
 			return middleware.NotImplemented("operation ServicesListServices has not yet been implemented")
 		}),
+		ServicesRollbackServiceHandler: services.RollbackServiceHandlerFunc(func(params services.RollbackServiceParams) middleware.Responder {
+			return middleware.NotImplemented("operation ServicesRollbackService has not yet been implemented")
+		}),
+		ServicesUndeployServiceHandler: services.UndeployServiceHandlerFunc(func(params services.UndeployServiceParams) middleware.Responder {
+			return middleware.NotImplemented("operation ServicesUndeployService has not yet been implemented")
+		}),
 	}
 }
 
@@ -80,12 +107,30 @@ type AnApplicationForEasierDistributedApplicationGenerationAPI struct {
 
 	// ServicesCreateOrUpdateServiceHandler sets the operation handler for the create or update service operation
 	ServicesCreateOrUpdateServiceHandler services.CreateOrUpdateServiceHandler
+	// ServicesCreatePlanHandler sets the operation handler for the create plan operation
+	ServicesCreatePlanHandler services.CreatePlanHandler
 	// ServicesDeleteServiceHandler sets the operation handler for the delete service operation
 	ServicesDeleteServiceHandler services.DeleteServiceHandler
+	// ServicesDeployServiceHandler sets the operation handler for the deploy service operation
+	ServicesDeployServiceHandler services.DeployServiceHandler
 	// ServicesGetServiceHandler sets the operation handler for the get service operation
 	ServicesGetServiceHandler services.GetServiceHandler
+	// ServicesGetServiceLogsHandler sets the operation handler for the get service logs operation
+	ServicesGetServiceLogsHandler services.GetServiceLogsHandler
+	// ServicesGetServiceRevisionHandler sets the operation handler for the get service revision operation
+	ServicesGetServiceRevisionHandler services.GetServiceRevisionHandler
+	// ServicesGetServiceStatusHandler sets the operation handler for the get service status operation
+	ServicesGetServiceStatusHandler services.GetServiceStatusHandler
+	// ServicesListAuditEntriesHandler sets the operation handler for the list audit entries operation
+	ServicesListAuditEntriesHandler services.ListAuditEntriesHandler
+	// ServicesListServiceRevisionsHandler sets the operation handler for the list service revisions operation
+	ServicesListServiceRevisionsHandler services.ListServiceRevisionsHandler
 	// ServicesListServicesHandler sets the operation handler for the list services operation
 	ServicesListServicesHandler services.ListServicesHandler
+	// ServicesRollbackServiceHandler sets the operation handler for the rollback service operation
+	ServicesRollbackServiceHandler services.RollbackServiceHandler
+	// ServicesUndeployServiceHandler sets the operation handler for the undeploy service operation
+	ServicesUndeployServiceHandler services.UndeployServiceHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -153,18 +198,54 @@ func (o *AnApplicationForEasierDistributedApplicationGenerationAPI) Validate() e
 		unregistered = append(unregistered, "services.CreateOrUpdateServiceHandler")
 	}
 
+	if o.ServicesCreatePlanHandler == nil {
+		unregistered = append(unregistered, "services.CreatePlanHandler")
+	}
+
 	if o.ServicesDeleteServiceHandler == nil {
 		unregistered = append(unregistered, "services.DeleteServiceHandler")
 	}
 
+	if o.ServicesDeployServiceHandler == nil {
+		unregistered = append(unregistered, "services.DeployServiceHandler")
+	}
+
 	if o.ServicesGetServiceHandler == nil {
 		unregistered = append(unregistered, "services.GetServiceHandler")
 	}
 
+	if o.ServicesGetServiceLogsHandler == nil {
+		unregistered = append(unregistered, "services.GetServiceLogsHandler")
+	}
+
+	if o.ServicesGetServiceRevisionHandler == nil {
+		unregistered = append(unregistered, "services.GetServiceRevisionHandler")
+	}
+
+	if o.ServicesGetServiceStatusHandler == nil {
+		unregistered = append(unregistered, "services.GetServiceStatusHandler")
+	}
+
+	if o.ServicesListAuditEntriesHandler == nil {
+		unregistered = append(unregistered, "services.ListAuditEntriesHandler")
+	}
+
+	if o.ServicesListServiceRevisionsHandler == nil {
+		unregistered = append(unregistered, "services.ListServiceRevisionsHandler")
+	}
+
 	if o.ServicesListServicesHandler == nil {
 		unregistered = append(unregistered, "services.ListServicesHandler")
 	}
 
+	if o.ServicesRollbackServiceHandler == nil {
+		unregistered = append(unregistered, "services.RollbackServiceHandler")
+	}
+
+	if o.ServicesUndeployServiceHandler == nil {
+		unregistered = append(unregistered, "services.UndeployServiceHandler")
+	}
+
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
 	}
@@ -260,21 +341,66 @@ func (o *AnApplicationForEasierDistributedApplicationGenerationAPI) initHandlerC
 	}
 	o.handlers["PUT"]["/services/{name}"] = services.NewCreateOrUpdateService(o.context, o.ServicesCreateOrUpdateServiceHandler)
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/plans"] = services.NewCreatePlan(o.context, o.ServicesCreatePlanHandler)
+
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/services/{name}"] = services.NewDeleteService(o.context, o.ServicesDeleteServiceHandler)
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/services/{name}/deploy"] = services.NewDeployService(o.context, o.ServicesDeployServiceHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/services/{name}"] = services.NewGetService(o.context, o.ServicesGetServiceHandler)
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/{name}/logs"] = services.NewGetServiceLogs(o.context, o.ServicesGetServiceLogsHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/{name}/revisions/{revision}"] = services.NewGetServiceRevision(o.context, o.ServicesGetServiceRevisionHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/{name}/status"] = services.NewGetServiceStatus(o.context, o.ServicesGetServiceStatusHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/audit"] = services.NewListAuditEntries(o.context, o.ServicesListAuditEntriesHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/{name}/revisions"] = services.NewListServiceRevisions(o.context, o.ServicesListServiceRevisionsHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/services"] = services.NewListServices(o.context, o.ServicesListServicesHandler)
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/services/{name}/rollback"] = services.NewRollbackService(o.context, o.ServicesRollbackServiceHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/services/{name}/undeploy"] = services.NewUndeployService(o.context, o.ServicesUndeployServiceHandler)
+
 }
 
 // Serve creates a http handler to serve the API over HTTP
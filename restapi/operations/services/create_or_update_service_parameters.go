@@ -33,15 +33,27 @@ type CreateOrUpdateServiceParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*who made the change, kept in the revision history
+	  In: query
+	*/
+	Author *string
 	/*
 	  In: body
 	*/
 	Body *models.Service
+	/*only update the service if it still has one of these ETags
+	  In: header
+	*/
+	IfMatch *string
 	/*
 	  Required: true
 	  In: path
 	*/
 	Name string
+	/*what the change does, kept in the revision history
+	  In: query
+	*/
+	Summary *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -50,6 +62,13 @@ func (o *CreateOrUpdateServiceParams) BindRequest(r *http.Request, route *middle
 	var res []error
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
+	qAuthor, qhkAuthor, _ := qs.GetOK("author")
+	if err := o.bindAuthor(qAuthor, qhkAuthor, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body models.Service
@@ -67,17 +86,54 @@ func (o *CreateOrUpdateServiceParams) BindRequest(r *http.Request, route *middle
 
 	}
 
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rName, rhkName, _ := route.Params.GetOK("name")
 	if err := o.bindName(rName, rhkName, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qSummary, qhkSummary, _ := qs.GetOK("summary")
+	if err := o.bindSummary(qSummary, qhkSummary, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (o *CreateOrUpdateServiceParams) bindAuthor(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Author = &raw
+
+	return nil
+}
+
+func (o *CreateOrUpdateServiceParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.IfMatch = &raw
+
+	return nil
+}
+
 func (o *CreateOrUpdateServiceParams) bindName(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
 	if len(rawData) > 0 {
@@ -88,3 +144,17 @@ func (o *CreateOrUpdateServiceParams) bindName(rawData []string, hasKey bool, fo
 
 	return nil
 }
+
+func (o *CreateOrUpdateServiceParams) bindSummary(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Summary = &raw
+
+	return nil
+}
@@ -22,6 +22,11 @@ swagger:response createOrUpdateServiceOK
 */
 type CreateOrUpdateServiceOK struct {
 
+	/*the new resource version of the service
+
+	 */
+	ETag string `json:"ETag"`
+
 	/*
 	  In: Body
 	*/
@@ -33,6 +38,17 @@ func NewCreateOrUpdateServiceOK() *CreateOrUpdateServiceOK {
 	return &CreateOrUpdateServiceOK{}
 }
 
+// WithETag adds the eTag to the create or update service o k response
+func (o *CreateOrUpdateServiceOK) WithETag(eTag string) *CreateOrUpdateServiceOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the create or update service o k response
+func (o *CreateOrUpdateServiceOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the create or update service o k response
 func (o *CreateOrUpdateServiceOK) WithPayload(payload *models.Service) *CreateOrUpdateServiceOK {
 	o.Payload = payload
@@ -47,6 +63,13 @@ func (o *CreateOrUpdateServiceOK) SetPayload(payload *models.Service) {
 // WriteResponse to the client
 func (o *CreateOrUpdateServiceOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -56,6 +79,178 @@ func (o *CreateOrUpdateServiceOK) WriteResponse(rw http.ResponseWriter, producer
 	}
 }
 
+// CreateOrUpdateServiceBadRequestCode is the HTTP code returned for type CreateOrUpdateServiceBadRequest
+const CreateOrUpdateServiceBadRequestCode int = 400
+
+/*CreateOrUpdateServiceBadRequest the body is missing or doesn't match the path
+
+swagger:response createOrUpdateServiceBadRequest
+*/
+type CreateOrUpdateServiceBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateOrUpdateServiceBadRequest creates CreateOrUpdateServiceBadRequest with default headers values
+func NewCreateOrUpdateServiceBadRequest() *CreateOrUpdateServiceBadRequest {
+	return &CreateOrUpdateServiceBadRequest{}
+}
+
+// WithPayload adds the payload to the create or update service bad request response
+func (o *CreateOrUpdateServiceBadRequest) WithPayload(payload *models.Error) *CreateOrUpdateServiceBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create or update service bad request response
+func (o *CreateOrUpdateServiceBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateOrUpdateServiceBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateOrUpdateServiceConflictCode is the HTTP code returned for type CreateOrUpdateServiceConflict
+const CreateOrUpdateServiceConflictCode int = 409
+
+/*CreateOrUpdateServiceConflict the resource version of the body isn't the stored one
+
+swagger:response createOrUpdateServiceConflict
+*/
+type CreateOrUpdateServiceConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateOrUpdateServiceConflict creates CreateOrUpdateServiceConflict with default headers values
+func NewCreateOrUpdateServiceConflict() *CreateOrUpdateServiceConflict {
+	return &CreateOrUpdateServiceConflict{}
+}
+
+// WithPayload adds the payload to the create or update service conflict response
+func (o *CreateOrUpdateServiceConflict) WithPayload(payload *models.Error) *CreateOrUpdateServiceConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create or update service conflict response
+func (o *CreateOrUpdateServiceConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateOrUpdateServiceConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateOrUpdateServicePreconditionFailedCode is the HTTP code returned for type CreateOrUpdateServicePreconditionFailed
+const CreateOrUpdateServicePreconditionFailedCode int = 412
+
+/*CreateOrUpdateServicePreconditionFailed If-Match doesn't match the stored service
+
+swagger:response createOrUpdateServicePreconditionFailed
+*/
+type CreateOrUpdateServicePreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateOrUpdateServicePreconditionFailed creates CreateOrUpdateServicePreconditionFailed with default headers values
+func NewCreateOrUpdateServicePreconditionFailed() *CreateOrUpdateServicePreconditionFailed {
+	return &CreateOrUpdateServicePreconditionFailed{}
+}
+
+// WithPayload adds the payload to the create or update service precondition failed response
+func (o *CreateOrUpdateServicePreconditionFailed) WithPayload(payload *models.Error) *CreateOrUpdateServicePreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create or update service precondition failed response
+func (o *CreateOrUpdateServicePreconditionFailed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateOrUpdateServicePreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateOrUpdateServiceUnprocessableEntityCode is the HTTP code returned for type CreateOrUpdateServiceUnprocessableEntity
+const CreateOrUpdateServiceUnprocessableEntityCode int = 422
+
+/*CreateOrUpdateServiceUnprocessableEntity the service is invalid
+
+swagger:response createOrUpdateServiceUnprocessableEntity
+*/
+type CreateOrUpdateServiceUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateOrUpdateServiceUnprocessableEntity creates CreateOrUpdateServiceUnprocessableEntity with default headers values
+func NewCreateOrUpdateServiceUnprocessableEntity() *CreateOrUpdateServiceUnprocessableEntity {
+	return &CreateOrUpdateServiceUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the create or update service unprocessable entity response
+func (o *CreateOrUpdateServiceUnprocessableEntity) WithPayload(payload *models.Error) *CreateOrUpdateServiceUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create or update service unprocessable entity response
+func (o *CreateOrUpdateServiceUnprocessableEntity) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateOrUpdateServiceUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*CreateOrUpdateServiceDefault error
 
 swagger:response createOrUpdateServiceDefault
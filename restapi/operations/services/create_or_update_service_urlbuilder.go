@@ -14,7 +14,9 @@ import (
 
 // CreateOrUpdateServiceURL generates an URL for the create or update service operation
 type CreateOrUpdateServiceURL struct {
-	Name string
+	Name    string
+	Author  *string
+	Summary *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -48,9 +50,30 @@ func (o *CreateOrUpdateServiceURL) Build() (*url.URL, error) {
 	} else {
 		return nil, errors.New("Name is required on CreateOrUpdateServiceURL")
 	}
+
 	_basePath := o._basePath
 	result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var author string
+	if o.Author != nil {
+		author = *o.Author
+	}
+	if author != "" {
+		qs.Set("author", author)
+	}
+
+	var summary string
+	if o.Summary != nil {
+		summary = *o.Summary
+	}
+	if summary != "" {
+		qs.Set("summary", summary)
+	}
+
+	result.RawQuery = qs.Encode()
+
 	return &result, nil
 }
 
@@ -11,15 +11,15 @@ import (
 	golangswaggerpaths "path"
 )
 
-// GetServicesURL generates an URL for the get services operation
-type GetServicesURL struct {
+// CreatePlanURL generates an URL for the create plan operation
+type CreatePlanURL struct {
 	_basePath string
 }
 
 // WithBasePath sets the base path for this url builder, only required when it's different from the
 // base path specified in the swagger spec.
 // When the value of the base path is an empty string
-func (o *GetServicesURL) WithBasePath(bp string) *GetServicesURL {
+func (o *CreatePlanURL) WithBasePath(bp string) *CreatePlanURL {
 	o.SetBasePath(bp)
 	return o
 }
@@ -27,15 +27,15 @@ func (o *GetServicesURL) WithBasePath(bp string) *GetServicesURL {
 // SetBasePath sets the base path for this url builder, only required when it's different from the
 // base path specified in the swagger spec.
 // When the value of the base path is an empty string
-func (o *GetServicesURL) SetBasePath(bp string) {
+func (o *CreatePlanURL) SetBasePath(bp string) {
 	o._basePath = bp
 }
 
 // Build a url path and query string
-func (o *GetServicesURL) Build() (*url.URL, error) {
+func (o *CreatePlanURL) Build() (*url.URL, error) {
 	var result url.URL
 
-	var _path = "/services"
+	var _path = "/plans"
 
 	_basePath := o._basePath
 	result.Path = golangswaggerpaths.Join(_basePath, _path)
@@ -44,7 +44,7 @@ func (o *GetServicesURL) Build() (*url.URL, error) {
 }
 
 // Must is a helper function to panic when the url builder returns an error
-func (o *GetServicesURL) Must(u *url.URL, err error) *url.URL {
+func (o *CreatePlanURL) Must(u *url.URL, err error) *url.URL {
 	if err != nil {
 		panic(err)
 	}
@@ -55,17 +55,17 @@ func (o *GetServicesURL) Must(u *url.URL, err error) *url.URL {
 }
 
 // String returns the string representation of the path with query string
-func (o *GetServicesURL) String() string {
+func (o *CreatePlanURL) String() string {
 	return o.Must(o.Build()).String()
 }
 
 // BuildFull builds a full url with scheme, host, path and query string
-func (o *GetServicesURL) BuildFull(scheme, host string) (*url.URL, error) {
+func (o *CreatePlanURL) BuildFull(scheme, host string) (*url.URL, error) {
 	if scheme == "" {
-		return nil, errors.New("scheme is required for a full url on GetServicesURL")
+		return nil, errors.New("scheme is required for a full url on CreatePlanURL")
 	}
 	if host == "" {
-		return nil, errors.New("host is required for a full url on GetServicesURL")
+		return nil, errors.New("host is required for a full url on CreatePlanURL")
 	}
 
 	base, err := o.Build()
@@ -79,6 +79,6 @@ func (o *GetServicesURL) BuildFull(scheme, host string) (*url.URL, error) {
 }
 
 // StringFull returns the string representation of a complete url
-func (o *GetServicesURL) StringFull(scheme, host string) string {
+func (o *CreatePlanURL) StringFull(scheme, host string) string {
 	return o.Must(o.BuildFull(scheme, host)).String()
 }
@@ -30,6 +30,10 @@ type DeleteServiceParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*only delete the service if it still has one of these ETags
+	  In: header
+	*/
+	IfMatch *string
 	/*
 	  Required: true
 	  In: path
@@ -43,6 +47,10 @@ func (o *DeleteServiceParams) BindRequest(r *http.Request, route *middleware.Mat
 	var res []error
 	o.HTTPRequest = r
 
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rName, rhkName, _ := route.Params.GetOK("name")
 	if err := o.bindName(rName, rhkName, route.Formats); err != nil {
 		res = append(res, err)
@@ -54,6 +62,20 @@ func (o *DeleteServiceParams) BindRequest(r *http.Request, route *middleware.Mat
 	return nil
 }
 
+func (o *DeleteServiceParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.IfMatch = &raw
+
+	return nil
+}
+
 func (o *DeleteServiceParams) bindName(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
 	if len(rawData) > 0 {
@@ -36,6 +36,49 @@ func (o *DeleteServiceNoContent) WriteResponse(rw http.ResponseWriter, producer
 	rw.WriteHeader(204)
 }
 
+// DeleteServicePreconditionFailedCode is the HTTP code returned for type DeleteServicePreconditionFailed
+const DeleteServicePreconditionFailedCode int = 412
+
+/*DeleteServicePreconditionFailed If-Match doesn't match the stored service
+
+swagger:response deleteServicePreconditionFailed
+*/
+type DeleteServicePreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteServicePreconditionFailed creates DeleteServicePreconditionFailed with default headers values
+func NewDeleteServicePreconditionFailed() *DeleteServicePreconditionFailed {
+	return &DeleteServicePreconditionFailed{}
+}
+
+// WithPayload adds the payload to the delete service precondition failed response
+func (o *DeleteServicePreconditionFailed) WithPayload(payload *models.Error) *DeleteServicePreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete service precondition failed response
+func (o *DeleteServicePreconditionFailed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteServicePreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*DeleteServiceDefault error
 
 swagger:response deleteServiceDefault
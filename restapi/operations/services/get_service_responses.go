@@ -22,6 +22,11 @@ swagger:response getServiceOK
 */
 type GetServiceOK struct {
 
+	/*the resource version of the service
+
+	 */
+	ETag string `json:"ETag"`
+
 	/*
 	  In: Body
 	*/
@@ -33,6 +38,17 @@ func NewGetServiceOK() *GetServiceOK {
 	return &GetServiceOK{}
 }
 
+// WithETag adds the eTag to the get service o k response
+func (o *GetServiceOK) WithETag(eTag string) *GetServiceOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the get service o k response
+func (o *GetServiceOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the get service o k response
 func (o *GetServiceOK) WithPayload(payload *models.Service) *GetServiceOK {
 	o.Payload = payload
@@ -47,6 +63,13 @@ func (o *GetServiceOK) SetPayload(payload *models.Service) {
 // WriteResponse to the client
 func (o *GetServiceOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
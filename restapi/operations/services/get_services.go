@@ -1,58 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package services
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the generate command
-
-import (
-	"net/http"
-
-	middleware "github.com/go-openapi/runtime/middleware"
-)
-
-// GetServicesHandlerFunc turns a function with the right signature into a get services handler
-type GetServicesHandlerFunc func(GetServicesParams) middleware.Responder
-
-// Handle executing the request and returning a response
-func (fn GetServicesHandlerFunc) Handle(params GetServicesParams) middleware.Responder {
-	return fn(params)
-}
-
-// GetServicesHandler interface for that can handle valid get services params
-type GetServicesHandler interface {
-	Handle(GetServicesParams) middleware.Responder
-}
-
-// NewGetServices creates a new http.Handler for the get services operation
-func NewGetServices(ctx *middleware.Context, handler GetServicesHandler) *GetServices {
-	return &GetServices{Context: ctx, Handler: handler}
-}
-
-/*GetServices swagger:route GET /services services getServices
-
-GetServices get services API
-
-*/
-type GetServices struct {
-	Context *middleware.Context
-	Handler GetServicesHandler
-}
-
-func (o *GetServices) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
-	route, rCtx, _ := o.Context.RouteInfo(r)
-	if rCtx != nil {
-		r = rCtx
-	}
-	var Params = NewGetServicesParams()
-
-	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
-		o.Context.Respond(rw, r, route.Produces, route, err)
-		return
-	}
-
-	res := o.Handler.Handle(Params) // actually handle the request
-
-	o.Context.Respond(rw, r, route.Produces, route, res)
-
-}
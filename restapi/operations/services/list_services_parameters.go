@@ -9,7 +9,12 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	strfmt "github.com/go-openapi/strfmt"
 )
 
 // NewListServicesParams creates a new ListServicesParams object
@@ -27,6 +32,41 @@ type ListServicesParams struct {
 
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
+
+	/*only the services deployed to this backend
+	  Enum: [kubernetes,docker,aci]
+	  In: query
+	*/
+	Backend *string
+	/*the token of the previous page, from its X-Continue header
+	  In: query
+	*/
+	Continue *string
+	/*only the services that are, or aren't, deployed
+	  In: query
+	*/
+	Deployed *bool
+	/*only the services whose labels match, like app=web,tier!=db
+	  In: query
+	*/
+	LabelSelector *string
+	/*the most services to return, the rest are fetched with the continue token
+	  Minimum: 1
+	  In: query
+	*/
+	Limit *int32
+	/*only the services whose names start with this
+	  In: query
+	*/
+	Prefix *string
+	/*with watch, only stream the changes after this resource version. Without it every service is sent as ADDED first
+	  In: query
+	*/
+	ResourceVersion *int64
+	/*stream the changes to the services instead of listing them
+	  In: query
+	*/
+	Watch *bool
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -35,8 +75,204 @@ func (o *ListServicesParams) BindRequest(r *http.Request, route *middleware.Matc
 	var res []error
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
+	qBackend, qhkBackend, _ := qs.GetOK("backend")
+	if err := o.bindBackend(qBackend, qhkBackend, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qContinue, qhkContinue, _ := qs.GetOK("continue")
+	if err := o.bindContinue(qContinue, qhkContinue, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qDeployed, qhkDeployed, _ := qs.GetOK("deployed")
+	if err := o.bindDeployed(qDeployed, qhkDeployed, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLabelSelector, qhkLabelSelector, _ := qs.GetOK("labelSelector")
+	if err := o.bindLabelSelector(qLabelSelector, qhkLabelSelector, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qPrefix, qhkPrefix, _ := qs.GetOK("prefix")
+	if err := o.bindPrefix(qPrefix, qhkPrefix, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qResourceVersion, qhkResourceVersion, _ := qs.GetOK("resourceVersion")
+	if err := o.bindResourceVersion(qResourceVersion, qhkResourceVersion, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qWatch, qhkWatch, _ := qs.GetOK("watch")
+	if err := o.bindWatch(qWatch, qhkWatch, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
+
+func (o *ListServicesParams) bindBackend(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Backend = &raw
+
+	if err := o.validateBackend(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ListServicesParams) validateBackend(formats strfmt.Registry) error {
+
+	if err := validate.Enum("backend", "query", *o.Backend, []interface{}{"kubernetes", "docker", "aci"}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ListServicesParams) bindContinue(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Continue = &raw
+
+	return nil
+}
+
+func (o *ListServicesParams) bindDeployed(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("deployed", "query", "bool", raw)
+	}
+	o.Deployed = &value
+
+	return nil
+}
+
+func (o *ListServicesParams) bindLabelSelector(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.LabelSelector = &raw
+
+	return nil
+}
+
+func (o *ListServicesParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt32(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int32", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ListServicesParams) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("limit", "query", int64(*o.Limit), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ListServicesParams) bindPrefix(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Prefix = &raw
+
+	return nil
+}
+
+func (o *ListServicesParams) bindResourceVersion(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("resourceVersion", "query", "int64", raw)
+	}
+	o.ResourceVersion = &value
+
+	return nil
+}
+
+func (o *ListServicesParams) bindWatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("watch", "query", "bool", raw)
+	}
+	o.Watch = &value
+
+	return nil
+}
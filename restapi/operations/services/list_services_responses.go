@@ -16,12 +16,17 @@ import (
 // ListServicesOKCode is the HTTP code returned for type ListServicesOK
 const ListServicesOKCode int = 200
 
-/*ListServicesOK list the services
+/*ListServicesOK list the services ordered by name, or with watch a stream of watchEvent objects, one JSON object per line or one server-sent event each
 
 swagger:response listServicesOK
 */
 type ListServicesOK struct {
 
+	/*the token that fetches the next page, missing on the last one
+
+	 */
+	XContinue string `json:"X-Continue"`
+
 	/*
 	  In: Body
 	*/
@@ -33,6 +38,17 @@ func NewListServicesOK() *ListServicesOK {
 	return &ListServicesOK{}
 }
 
+// WithXContinue adds the xContinue to the list services o k response
+func (o *ListServicesOK) WithXContinue(xContinue string) *ListServicesOK {
+	o.XContinue = xContinue
+	return o
+}
+
+// SetXContinue sets the xContinue to the list services o k response
+func (o *ListServicesOK) SetXContinue(xContinue string) {
+	o.XContinue = xContinue
+}
+
 // WithPayload adds the payload to the list services o k response
 func (o *ListServicesOK) WithPayload(payload models.ListServicesOKBody) *ListServicesOK {
 	o.Payload = payload
@@ -47,6 +63,13 @@ func (o *ListServicesOK) SetPayload(payload models.ListServicesOKBody) {
 // WriteResponse to the client
 func (o *ListServicesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header X-Continue
+
+	xContinue := o.XContinue
+	if xContinue != "" {
+		rw.Header().Set("X-Continue", xContinue)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
@@ -58,3 +81,147 @@ func (o *ListServicesOK) WriteResponse(rw http.ResponseWriter, producer runtime.
 	}
 
 }
+
+// ListServicesBadRequestCode is the HTTP code returned for type ListServicesBadRequest
+const ListServicesBadRequestCode int = 400
+
+/*ListServicesBadRequest the label selector or continue token is invalid
+
+swagger:response listServicesBadRequest
+*/
+type ListServicesBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListServicesBadRequest creates ListServicesBadRequest with default headers values
+func NewListServicesBadRequest() *ListServicesBadRequest {
+	return &ListServicesBadRequest{}
+}
+
+// WithPayload adds the payload to the list services bad request response
+func (o *ListServicesBadRequest) WithPayload(payload *models.Error) *ListServicesBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list services bad request response
+func (o *ListServicesBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListServicesBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListServicesGoneCode is the HTTP code returned for type ListServicesGone
+const ListServicesGoneCode int = 410
+
+/*ListServicesGone the changes after resourceVersion are no longer known, list the services and watch from there
+
+swagger:response listServicesGone
+*/
+type ListServicesGone struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListServicesGone creates ListServicesGone with default headers values
+func NewListServicesGone() *ListServicesGone {
+	return &ListServicesGone{}
+}
+
+// WithPayload adds the payload to the list services gone response
+func (o *ListServicesGone) WithPayload(payload *models.Error) *ListServicesGone {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list services gone response
+func (o *ListServicesGone) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListServicesGone) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(410)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListServicesDefault error
+
+swagger:response listServicesDefault
+*/
+type ListServicesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListServicesDefault creates ListServicesDefault with default headers values
+func NewListServicesDefault(code int) *ListServicesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListServicesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list services default response
+func (o *ListServicesDefault) WithStatusCode(code int) *ListServicesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list services default response
+func (o *ListServicesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list services default response
+func (o *ListServicesDefault) WithPayload(payload *models.Error) *ListServicesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list services default response
+func (o *ListServicesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListServicesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -9,11 +9,24 @@ import (
 	"errors"
 	"net/url"
 	golangswaggerpaths "path"
+
+	"github.com/go-openapi/swag"
 )
 
 // ListServicesURL generates an URL for the list services operation
 type ListServicesURL struct {
+	Backend         *string
+	Continue        *string
+	Deployed        *bool
+	LabelSelector   *string
+	Limit           *int32
+	Prefix          *string
+	ResourceVersion *int64
+	Watch           *bool
+
 	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
 }
 
 // WithBasePath sets the base path for this url builder, only required when it's different from the
@@ -40,6 +53,74 @@ func (o *ListServicesURL) Build() (*url.URL, error) {
 	_basePath := o._basePath
 	result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var backend string
+	if o.Backend != nil {
+		backend = *o.Backend
+	}
+	if backend != "" {
+		qs.Set("backend", backend)
+	}
+
+	var continueVar string
+	if o.Continue != nil {
+		continueVar = *o.Continue
+	}
+	if continueVar != "" {
+		qs.Set("continue", continueVar)
+	}
+
+	var deployed string
+	if o.Deployed != nil {
+		deployed = swag.FormatBool(*o.Deployed)
+	}
+	if deployed != "" {
+		qs.Set("deployed", deployed)
+	}
+
+	var labelSelector string
+	if o.LabelSelector != nil {
+		labelSelector = *o.LabelSelector
+	}
+	if labelSelector != "" {
+		qs.Set("labelSelector", labelSelector)
+	}
+
+	var limit string
+	if o.Limit != nil {
+		limit = swag.FormatInt32(*o.Limit)
+	}
+	if limit != "" {
+		qs.Set("limit", limit)
+	}
+
+	var prefix string
+	if o.Prefix != nil {
+		prefix = *o.Prefix
+	}
+	if prefix != "" {
+		qs.Set("prefix", prefix)
+	}
+
+	var resourceVersion string
+	if o.ResourceVersion != nil {
+		resourceVersion = swag.FormatInt64(*o.ResourceVersion)
+	}
+	if resourceVersion != "" {
+		qs.Set("resourceVersion", resourceVersion)
+	}
+
+	var watch string
+	if o.Watch != nil {
+		watch = swag.FormatBool(*o.Watch)
+	}
+	if watch != "" {
+		qs.Set("watch", watch)
+	}
+
+	result.RawQuery = qs.Encode()
+
 	return &result, nil
 }
 
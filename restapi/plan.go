@@ -0,0 +1,151 @@
+package restapi
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/metaparticle-io/metaparticle-ast/compiler"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+	"github.com/metaparticle-io/metaparticle-ast/restapi/operations/services"
+)
+
+// planRecorder collects the steps of a dry run, and whatever the plan writes
+// as text.
+type planRecorder struct {
+	bytes.Buffer
+	steps []*models.PlanStep
+}
+
+func (p *planRecorder) Command(cmd []string) {
+	p.steps = append(p.steps, &models.PlanStep{Command: cmd})
+}
+
+func (p *planRecorder) Object(name string, obj interface{}) {
+	p.steps = append(p.steps, &models.PlanStep{Name: name, Object: obj})
+}
+
+func (p *planRecorder) Note(message string) {
+	p.steps = append(p.steps, &models.PlanStep{Description: message})
+}
+
+// shellSafe matches the arguments that don't need quoting.
+var shellSafe = regexp.MustCompile(`^[A-Za-z0-9_@%+=:,./-]+$`)
+
+// shellQuote quotes an argument for a POSIX shell.
+func shellQuote(arg string) string {
+	if shellSafe.MatchString(arg) {
+		return arg
+	}
+	return "'" + strings.Replace(arg, "'", `'"'"'`, -1) + "'"
+}
+
+// manifest renders the steps of a plan as text: Kubernetes objects as YAML
+// documents, commands as lines of a shell script and the rest as comments.
+func manifest(steps []*models.PlanStep) (string, error) {
+	out := &bytes.Buffer{}
+	for _, step := range steps {
+		switch {
+		case step.Object != nil:
+			data, err := yaml.Marshal(step.Object)
+			if err != nil {
+				return "", err
+			}
+			fmt.Fprintf(out, "---\n# %s\n%s", step.Name, data)
+		case len(step.Command) > 0:
+			args := make([]string, len(step.Command))
+			for ix, arg := range step.Command {
+				args[ix] = shellQuote(arg)
+			}
+			fmt.Fprintln(out, strings.Join(args, " "))
+		default:
+			fmt.Fprintf(out, "# %s\n", step.Description)
+		}
+	}
+	return out.String(), nil
+}
+
+// HandleCreatePlan implements the CreatePlanHandler interface. The plan is
+// rendered by a dry run, so nothing is executed, and neither the files nor
+// the environment of the server are read. Planning a stored service takes
+// the reader role, planning a spec takes the deployer role.
+func (i *Impl) HandleCreatePlan(params services.CreatePlanParams) middleware.Responder {
+	request := params.Request
+	if (len(request.Name) > 0) == (request.Service != nil) {
+		return services.NewCreatePlanBadRequest().WithPayload(apiError(http.StatusBadRequest, "either the name of a stored service or a service is required"))
+	}
+	svc := request.Service
+	if svc == nil {
+		if !i.auth.Allowed(params.HTTPRequest, RoleReader, request.Name) {
+			return services.NewCreatePlanDefault(http.StatusForbidden).WithPayload(forbidden(params.HTTPRequest, RoleReader, request.Name))
+		}
+		i.Lock()
+		stored, err := i.store.Get(request.Name)
+		i.Unlock()
+		if err != nil {
+			return services.NewCreatePlanDefault(http.StatusInternalServerError).WithPayload(apiError(http.StatusInternalServerError, err.Error()))
+		}
+		if stored == nil {
+			return services.NewCreatePlanNotFound().WithPayload(apiError(http.StatusNotFound, "service "+request.Name+" not found"))
+		}
+		svc = stored
+	} else {
+		// a spec that isn't stored could be anything the caller may deploy
+		if !i.auth.Allowed(params.HTTPRequest, RoleDeployer, *svc.Name) {
+			return services.NewCreatePlanDefault(http.StatusForbidden).WithPayload(forbidden(params.HTTPRequest, RoleDeployer, *svc.Name))
+		}
+	}
+	// stored services are validated again, they may predate checks the
+	// compilers rely on
+	if err := compiler.Validate(svc); err != nil {
+		return services.NewCreatePlanUnprocessableEntity().WithPayload(apiError(http.StatusUnprocessableEntity, err.Error()))
+	}
+	// plans show the registry credentials of the server that a spec would use
+	if !request.Undeploy {
+		if err := i.registries.checkRegistries(svc); err != nil {
+			return services.NewCreatePlanUnprocessableEntity().WithPayload(apiError(http.StatusUnprocessableEntity, err.Error()))
+		}
+	}
+
+	backend := request.Backend
+	if len(backend) == 0 {
+		backend = defaultBackend
+	}
+	cmp, err := i.compilerFor(backend)
+	if err != nil {
+		return services.NewCreatePlanDefault(http.StatusInternalServerError).WithPayload(apiError(http.StatusInternalServerError, err.Error()))
+	}
+	recorder := &planRecorder{steps: []*models.PlanStep{}}
+	opts := &compiler.CompilerOptions{Output: recorder}
+	var plan compiler.Plan
+	if request.Undeploy {
+		plan, err = cmp.Delete(opts, svc)
+	} else {
+		plan, err = cmp.Compile(opts, svc)
+	}
+	if err == nil {
+		err = i.runPlan(plan, true)
+	}
+	if panicked, ok := err.(*planPanic); ok {
+		return services.NewCreatePlanDefault(http.StatusInternalServerError).WithPayload(apiError(http.StatusInternalServerError, panicked.Error()))
+	}
+	if err != nil {
+		return services.NewCreatePlanUnprocessableEntity().WithPayload(apiError(http.StatusUnprocessableEntity, err.Error()))
+	}
+
+	text, err := manifest(recorder.steps)
+	if err != nil {
+		return services.NewCreatePlanDefault(http.StatusInternalServerError).WithPayload(apiError(http.StatusInternalServerError, err.Error()))
+	}
+	return services.NewCreatePlanOK().WithPayload(&models.Plan{
+		Backend:  swag.String(backend),
+		Undeploy: request.Undeploy,
+		Steps:    recorder.steps,
+		Manifest: swag.String(text),
+	})
+}
@@ -0,0 +1,112 @@
+package restapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/metaparticle-io/metaparticle-ast/models"
+	"github.com/metaparticle-io/metaparticle-ast/restapi/operations/services"
+)
+
+const registrySpec = `{
+	"name": "shop",
+	"registries": [{"server": "registry.example.com", "username": "deploy", "passwordEnv": "SHOP_REGISTRY_PASSWORD"}],
+	"services": [{"name": "web", "containers": [{"image": "registry.example.com/web"}]}]
+}`
+
+// plan asks impl for the plan of a stored service or a spec as user.
+func plan(t *testing.T, impl *Impl, user string, request *models.PlanRequest) *httptest.ResponseRecorder {
+	t.Helper()
+	r := httptest.NewRequest(http.MethodPost, "/plans", nil)
+	if len(user) > 0 {
+		r = authAs(r, user)
+	}
+	return respond(impl.HandleCreatePlan(services.CreatePlanParams{HTTPRequest: r, Request: request}))
+}
+
+func TestCreatePlan(t *testing.T) {
+	allowed := &RegistryOptions{PasswordEnvs: []string{"registry.example.com=SHOP_REGISTRY_PASSWORD"}}
+	tests := []struct {
+		name       string
+		registries *RegistryOptions
+		user       string
+		stored     bool
+		undeploy   bool
+		code       int
+	}{
+		{name: "spec", registries: allowed, code: http.StatusOK},
+		{name: "stored", registries: allowed, stored: true, code: http.StatusOK},
+		{name: "spec as deployer", registries: allowed, user: "bob", code: http.StatusOK},
+		{name: "spec as reader", registries: allowed, user: "carol", code: http.StatusForbidden},
+		{name: "stored as reader", registries: allowed, user: "carol", stored: true, code: http.StatusOK},
+		{name: "stored without a role", registries: allowed, user: "dave", stored: true, code: http.StatusForbidden},
+		{name: "spec with a registry that isn't allowed", code: http.StatusUnprocessableEntity},
+		{name: "stored with a registry that isn't allowed", stored: true, code: http.StatusUnprocessableEntity},
+		{name: "undeploy with a registry that isn't allowed", stored: true, undeploy: true, code: http.StatusOK},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// dry runs don't read the password, so it doesn't need to be set
+			t.Setenv("SHOP_REGISTRY_PASSWORD", "")
+			impl := newTestImpl(t, test.registries)
+			if len(test.user) > 0 {
+				impl.auth = &Auth{grants: map[string][]grant{
+					"bob":   {{RoleDeployer, []string{"shop"}}},
+					"carol": {{RoleReader, []string{"shop"}}},
+				}}
+			}
+			svc := parseSpec(t, registrySpec)
+			request := &models.PlanRequest{Backend: models.PlanRequestBackendDocker, Undeploy: test.undeploy}
+			if test.stored {
+				// stored before the server changed its registry flags
+				if err := impl.store.Put("shop", svc); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				request.Name = "shop"
+			} else {
+				request.Service = svc
+			}
+
+			rw := plan(t, impl, test.user, request)
+			if rw.Code != test.code {
+				t.Fatalf("expected %d, got %d: %s", test.code, rw.Code, rw.Body.String())
+			}
+			if test.code != http.StatusOK || test.undeploy {
+				return
+			}
+			manifest := rw.Body.String()
+			if !strings.Contains(manifest, "docker login --username deploy --password-stdin registry.example.com") {
+				t.Errorf("expected a docker login, got %s", manifest)
+			}
+		})
+	}
+}
+
+func TestCreatePlanRequest(t *testing.T) {
+	impl := newTestImpl(t, nil)
+	invalid := parseSpec(t, `{"name": "shop", "services": [{"replicas": 2}]}`)
+	tests := []struct {
+		name    string
+		request *models.PlanRequest
+		code    int
+	}{
+		{name: "nothing to plan", request: &models.PlanRequest{}, code: http.StatusBadRequest},
+		{name: "both", request: &models.PlanRequest{Name: "web", Service: newService("web")}, code: http.StatusBadRequest},
+		{name: "unknown service", request: &models.PlanRequest{Name: "web"}, code: http.StatusNotFound},
+		{name: "invalid spec", request: &models.PlanRequest{Service: invalid}, code: http.StatusUnprocessableEntity},
+		{name: "invalid stored service", request: &models.PlanRequest{Name: "shop"}, code: http.StatusUnprocessableEntity},
+	}
+	// stored before the validation the compilers rely on
+	if err := impl.store.Put("shop", invalid); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if rw := plan(t, impl, "", test.request); rw.Code != test.code {
+				t.Errorf("expected %d, got %d: %s", test.code, rw.Code, rw.Body.String())
+			}
+		})
+	}
+}
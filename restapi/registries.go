@@ -0,0 +1,51 @@
+package restapi
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/metaparticle-io/metaparticle-ast/models"
+)
+
+// RegistryOptions are the command line flags that let specs use registry
+// credentials of the server. A spec names a docker config file or an
+// environment variable that the server reads and hands to the registry, so
+// each one is only allowed for the registry it is listed with.
+type RegistryOptions struct {
+	DockerConfigs []string `long:"registry-docker-config" description:"a 'server=file' docker config that specs may use for the registry server, can be repeated"`
+	PasswordEnvs  []string `long:"registry-password-env" description:"a 'server=variable' environment variable that specs may use as the password of the registry server, can be repeated"`
+}
+
+// allowed returns true if value is listed for a registry server.
+func allowed(list []string, server string, value string) bool {
+	server = strings.TrimSuffix(server, "/")
+	for _, entry := range list {
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) == 2 && strings.TrimSuffix(parts[0], "/") == server && parts[1] == value {
+			return true
+		}
+	}
+	return false
+}
+
+// checkRegistries returns an error if a spec uses credentials of the server
+// that aren't allowed for its registry. A nil RegistryOptions allows none.
+func (o *RegistryOptions) checkRegistries(svc *models.Service) error {
+	options := o
+	if options == nil {
+		options = &RegistryOptions{}
+	}
+	for _, registry := range svc.Registries {
+		if registry == nil || registry.Server == nil {
+			continue
+		}
+		server := *registry.Server
+		if len(registry.DockerConfig) > 0 && !allowed(options.DockerConfigs, server, registry.DockerConfig) {
+			return fmt.Errorf("registry %s: dockerConfig %s isn't allowed by the server, see --registry-docker-config", server, registry.DockerConfig)
+		}
+		if len(registry.PasswordEnv) > 0 && !allowed(options.PasswordEnvs, server, registry.PasswordEnv) {
+			return fmt.Errorf("registry %s: passwordEnv %s isn't allowed by the server, see --registry-password-env", server, registry.PasswordEnv)
+		}
+	}
+	return nil
+}
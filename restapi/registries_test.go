@@ -0,0 +1,96 @@
+package restapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+	"github.com/metaparticle-io/metaparticle-ast/restapi/operations/services"
+)
+
+func TestRegistryCredentials(t *testing.T) {
+	options := &RegistryOptions{
+		DockerConfigs: []string{"registry.example.com=/etc/mp/docker-config.json"},
+		PasswordEnvs:  []string{"registry.example.com/=REGISTRY_PASSWORD"},
+	}
+	tests := []struct {
+		name     string
+		options  *RegistryOptions
+		registry string
+		err      string
+	}{
+		{name: "no credentials", registry: `{"server": "registry.example.com", "secretName": "pull"}`},
+		{name: "allowed docker config", options: options, registry: `{"server": "registry.example.com/", "dockerConfig": "/etc/mp/docker-config.json"}`},
+		{name: "allowed password", options: options, registry: `{"server": "registry.example.com", "username": "deploy", "passwordEnv": "REGISTRY_PASSWORD"}`},
+		{
+			name:     "no flags",
+			registry: `{"server": "registry.example.com", "dockerConfig": "/etc/mp/docker-config.json"}`,
+			err:      "dockerConfig /etc/mp/docker-config.json isn't allowed",
+		},
+		{
+			name:     "other file",
+			options:  options,
+			registry: `{"server": "registry.example.com", "dockerConfig": "/etc/shadow"}`,
+			err:      "dockerConfig /etc/shadow isn't allowed",
+		},
+		{
+			name:     "other variable",
+			options:  options,
+			registry: `{"server": "registry.example.com", "username": "deploy", "passwordEnv": "AWS_SECRET_ACCESS_KEY"}`,
+			err:      "passwordEnv AWS_SECRET_ACCESS_KEY isn't allowed",
+		},
+		{
+			name:     "other registry",
+			options:  options,
+			registry: `{"server": "attacker.example.org", "username": "deploy", "passwordEnv": "REGISTRY_PASSWORD"}`,
+			err:      "passwordEnv REGISTRY_PASSWORD isn't allowed",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := parseSpec(t, `{"name": "web", "registries": [`+test.registry+`], "services": [{"name": "web", "containers": [{"image": "registry.example.com/web"}]}]}`)
+			err := test.options.checkRegistries(svc)
+			if len(test.err) == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("expected an error containing %q, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestRegistryCredentialsRefused(t *testing.T) {
+	spec := `{"name": "web", "registries": [{"server": "attacker.example.org", "username": "me", "passwordEnv": "AWS_SECRET_ACCESS_KEY"}], "services": [{"name": "web", "containers": [{"image": "attacker.example.org/web"}]}]}`
+	impl := newTestImpl(t, nil)
+
+	rw := respond(impl.HandleUpdateOne(services.CreateOrUpdateServiceParams{
+		HTTPRequest: httptest.NewRequest(http.MethodPut, "/services/web", nil),
+		Name:        "web",
+		Body:        parseSpec(t, spec),
+	}))
+	if rw.Code != http.StatusUnprocessableEntity || !strings.Contains(rw.Body.String(), "passwordEnv AWS_SECRET_ACCESS_KEY isn't allowed") {
+		t.Errorf("expected the PUT to be refused, got %d: %s", rw.Code, rw.Body.String())
+	}
+	if svc, _ := impl.store.Get("web"); svc != nil {
+		t.Errorf("the refused spec was stored")
+	}
+
+	// a spec stored before the server was restricted can't be deployed either
+	impl.store.Put("web", parseSpec(t, spec))
+	rw = respond(impl.HandleDeploy(services.DeployServiceParams{
+		HTTPRequest: httptest.NewRequest(http.MethodPost, "/services/web/deploy", nil),
+		Name:        "web",
+		Backend:     swag.String(models.DeploymentBackendDocker),
+		DryRun:      swag.Bool(true),
+	}))
+	if rw.Code != http.StatusUnprocessableEntity || !strings.Contains(rw.Body.String(), "isn't allowed") {
+		t.Errorf("expected the deploy to be refused, got %d: %s", rw.Code, rw.Body.String())
+	}
+}
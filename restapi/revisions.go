@@ -0,0 +1,200 @@
+package restapi
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+	"github.com/metaparticle-io/metaparticle-ast/restapi/operations/services"
+)
+
+// storeRevision stores a service as the newest version and revision of its
+// name. The revision is written first, numbered by the resource version the
+// store gives the service next, so a failed write never leaves a stored spec
+// without its revision. The caller holds the lock.
+func (i *Impl) storeRevision(svc *models.Service, author string, summary string) error {
+	version, err := i.store.Version()
+	if err != nil {
+		return err
+	}
+	svc.ResourceVersion = version + 1
+	if err := i.addRevision(svc, author, summary); err != nil {
+		return err
+	}
+	if err := i.store.Put(*svc.Name, svc); err != nil {
+		return err
+	}
+	if svc.ResourceVersion != version+1 {
+		return fmt.Errorf("service %s was stored as version %d, not as its revision %d", *svc.Name, svc.ResourceVersion, version+1)
+	}
+	return nil
+}
+
+// addRevision records a service as a revision numbered by its resource version.
+func (i *Impl) addRevision(svc *models.Service, author string, summary string) error {
+	return i.store.AddRevision(*svc.Name, &models.Revision{
+		Revision:  swag.Int64(svc.ResourceVersion),
+		Timestamp: strfmt.DateTime(time.Now()),
+		Author:    author,
+		Summary:   summary,
+		Service:   svc,
+	})
+}
+
+// keepUnrecorded adds a revision for a stored service whose spec differs
+// from its newest revision. Servers that wrote the revision after the
+// service could leave such a gap, and rolling back would lose the spec.
+func (i *Impl) keepUnrecorded(svc *models.Service) error {
+	revisions, err := i.store.Revisions(*svc.Name)
+	if err != nil {
+		return err
+	}
+	if len(revisions) > 0 && specDigest(revisions[len(revisions)-1].Service) == specDigest(svc) {
+		return nil
+	}
+	if rev, err := i.store.Revision(*svc.Name, svc.ResourceVersion); err != nil || rev != nil {
+		return err
+	}
+	return i.addRevision(svc, "", "recorded before rolling back, it had no revision")
+}
+
+// author returns who made a change: the authenticated user or, when
+// authentication is off, the author the request names.
+func author(r *http.Request, named *string) string {
+	if user := User(r); len(user) > 0 {
+		return user
+	}
+	return swag.StringValue(named)
+}
+
+// copyService returns a deep copy of a service, so that storing it again
+// doesn't change the revision it came from.
+func copyService(svc *models.Service) (*models.Service, error) {
+	data, err := svc.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	result := &models.Service{}
+	if err := result.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// HandleListRevisions implements the ListServiceRevisionsHandler interface
+func (i *Impl) HandleListRevisions(params services.ListServiceRevisionsParams) middleware.Responder {
+	if !i.auth.Allowed(params.HTTPRequest, RoleReader, params.Name) {
+		return services.NewListServiceRevisionsDefault(http.StatusForbidden).WithPayload(forbidden(params.HTTPRequest, RoleReader, params.Name))
+	}
+	i.Lock()
+	defer i.Unlock()
+	revisions, err := i.store.Revisions(params.Name)
+	if err != nil {
+		return services.NewListServiceRevisionsDefault(http.StatusInternalServerError).WithPayload(apiError(http.StatusInternalServerError, err.Error()))
+	}
+	if len(revisions) == 0 {
+		return services.NewListServiceRevisionsNotFound().WithPayload(apiError(http.StatusNotFound, "service "+params.Name+" has no revisions"))
+	}
+	result := models.ListServiceRevisionsOKBody{}
+	for _, rev := range revisions {
+		// the specs are fetched one revision at a time
+		result = append(result, &models.Revision{
+			Revision:  rev.Revision,
+			Timestamp: rev.Timestamp,
+			Author:    rev.Author,
+			Summary:   rev.Summary,
+		})
+	}
+	return services.NewListServiceRevisionsOK().WithPayload(result)
+}
+
+// HandleGetRevision implements the GetServiceRevisionHandler interface
+func (i *Impl) HandleGetRevision(params services.GetServiceRevisionParams) middleware.Responder {
+	if !i.auth.Allowed(params.HTTPRequest, RoleReader, params.Name) {
+		return services.NewGetServiceRevisionDefault(http.StatusForbidden).WithPayload(forbidden(params.HTTPRequest, RoleReader, params.Name))
+	}
+	i.Lock()
+	defer i.Unlock()
+	rev, err := i.store.Revision(params.Name, params.Revision)
+	if err != nil {
+		return services.NewGetServiceRevisionDefault(http.StatusInternalServerError).WithPayload(apiError(http.StatusInternalServerError, err.Error()))
+	}
+	if rev == nil {
+		message := fmt.Sprintf("service %s has no revision %d", params.Name, params.Revision)
+		return services.NewGetServiceRevisionNotFound().WithPayload(apiError(http.StatusNotFound, message))
+	}
+	return services.NewGetServiceRevisionOK().WithPayload(rev)
+}
+
+// HandleRollback implements the RollbackServiceHandler interface
+func (i *Impl) HandleRollback(params services.RollbackServiceParams) middleware.Responder {
+	entry := newAuditEntry(params.HTTPRequest, models.AuditEntryOperationRollback, params.Name)
+	return i.audited(entry, func() middleware.Responder {
+		return i.rollbackOne(params, entry)
+	})
+}
+
+func (i *Impl) rollbackOne(params services.RollbackServiceParams, entry *models.AuditEntry) middleware.Responder {
+	if !i.auth.Allowed(params.HTTPRequest, RoleDeployer, params.Name) {
+		return services.NewRollbackServiceDefault(http.StatusForbidden).WithPayload(forbidden(params.HTTPRequest, RoleDeployer, params.Name))
+	}
+	svc, apiErr := i.rollback(params.Name, params.Revision, author(params.HTTPRequest, params.Author), entry)
+	if apiErr != nil {
+		if apiErr.Code == http.StatusNotFound {
+			return services.NewRollbackServiceNotFound().WithPayload(apiErr)
+		}
+		return services.NewRollbackServiceDefault(int(apiErr.Code)).WithPayload(apiErr)
+	}
+	result := &models.Rollback{Service: svc}
+	if params.Deploy != nil && *params.Deploy {
+		result.Deployment, apiErr = i.execute(params.Name, params.Backend, params.DryRun, false, entry)
+		if apiErr != nil {
+			return services.NewRollbackServiceDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+	}
+	return services.NewRollbackServiceOK().WithPayload(result)
+}
+
+// rollback stores a revision of a service again, as its newest revision.
+// A deleted service is brought back.
+func (i *Impl) rollback(name string, revision int64, author string, entry *models.AuditEntry) (*models.Service, *models.Error) {
+	i.Lock()
+	defer i.Unlock()
+	rev, err := i.store.Revision(name, revision)
+	if err != nil {
+		return nil, apiError(http.StatusInternalServerError, err.Error())
+	}
+	if rev == nil || rev.Service == nil {
+		return nil, apiError(http.StatusNotFound, fmt.Sprintf("service %s has no revision %d", name, revision))
+	}
+	existing, err := i.store.Get(name)
+	if err != nil {
+		return nil, apiError(http.StatusInternalServerError, err.Error())
+	}
+	svc, err := copyService(rev.Service)
+	if err != nil {
+		return nil, apiError(http.StatusInternalServerError, err.Error())
+	}
+	svc.DeployedBackend = ""
+	if existing != nil {
+		svc.DeployedBackend = existing.DeployedBackend
+		if err := i.keepUnrecorded(existing); err != nil {
+			return nil, apiError(http.StatusInternalServerError, err.Error())
+		}
+	}
+	if err := i.storeRevision(svc, author, fmt.Sprintf("rolled back to revision %d", revision)); err != nil {
+		return nil, apiError(http.StatusInternalServerError, err.Error())
+	}
+	entry.OldDigest = specDigest(existing)
+	entry.NewDigest = specDigest(svc)
+	if existing == nil {
+		i.publish(models.WatchEventTypeADDED, svc)
+	} else {
+		i.publish(models.WatchEventTypeMODIFIED, svc)
+	}
+	return svc, nil
+}
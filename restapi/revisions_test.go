@@ -0,0 +1,123 @@
+package restapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/metaparticle-io/metaparticle-ast/models"
+	"github.com/metaparticle-io/metaparticle-ast/restapi/operations/services"
+)
+
+// failingStore fails the writes it is told to.
+type failingStore struct {
+	Store
+	failPut      bool
+	failRevision bool
+}
+
+func (f *failingStore) Put(name string, svc *models.Service) error {
+	if f.failPut {
+		return fmt.Errorf("disk full")
+	}
+	return f.Store.Put(name, svc)
+}
+
+func (f *failingStore) AddRevision(name string, rev *models.Revision) error {
+	if f.failRevision {
+		return fmt.Errorf("disk full")
+	}
+	return f.Store.AddRevision(name, rev)
+}
+
+// revisionNumbers returns the numbers and summaries of the revisions of a service.
+func revisionNumbers(t *testing.T, store Store, name string) []string {
+	t.Helper()
+	revisions, err := store.Revisions(name)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := []string{}
+	for _, rev := range revisions {
+		result = append(result, fmt.Sprintf("%d %s", *rev.Revision, rev.Summary))
+	}
+	return result
+}
+
+func TestStoreRevisionFailures(t *testing.T) {
+	tests := []struct {
+		name         string
+		failPut      bool
+		failRevision bool
+		code         int
+		replicas     int32
+	}{
+		{name: "stored", code: http.StatusOK, replicas: 2},
+		{name: "revision fails", failRevision: true, code: http.StatusInternalServerError, replicas: 1},
+		{name: "store fails", failPut: true, code: http.StatusInternalServerError, replicas: 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store := &failingStore{Store: NewMemoryStore()}
+			impl, _ := NewImpl(store, nil, NewMemoryAuditLog(), nil)
+			put(t, impl, "web", `{"name": "web", "services": [{"name": "web", "replicas": 1}]}`, "")
+
+			store.failPut, store.failRevision = test.failPut, test.failRevision
+			rw := put(t, impl, "web", `{"name": "web", "services": [{"name": "web", "replicas": 2}]}`, "")
+			if rw.Code != test.code {
+				t.Fatalf("expected %d, got %d: %s", test.code, rw.Code, rw.Body.String())
+			}
+			svc, _ := store.Get("web")
+			if replicas := svc.Services[0].Replicas; replicas != test.replicas {
+				t.Errorf("expected %d replicas to be stored, got %d", test.replicas, replicas)
+			}
+			// every stored spec has a revision
+			rev, _ := store.Revision("web", svc.ResourceVersion)
+			if rev == nil || rev.Service.Services[0].Replicas != test.replicas {
+				t.Errorf("the stored version %d has no matching revision: %v", svc.ResourceVersion, rev)
+			}
+		})
+	}
+}
+
+func TestRollback(t *testing.T) {
+	rollback := func(impl *Impl, revision int64) *httptest.ResponseRecorder {
+		return respond(impl.HandleRollback(services.RollbackServiceParams{
+			HTTPRequest: httptest.NewRequest(http.MethodPost, "/services/web/rollback", nil),
+			Name:        "web",
+			Revision:    revision,
+		}))
+	}
+
+	impl := newTestImpl(t, nil)
+	put(t, impl, "web", `{"name": "web", "services": [{"name": "web", "replicas": 1}]}`, "")
+	put(t, impl, "web", `{"name": "web", "services": [{"name": "web", "replicas": 2}]}`, "")
+	// a spec stored without its revision, as left by a failed revision write
+	impl.store.Put("web", parseSpec(t, `{"name": "web", "services": [{"name": "web", "replicas": 3}]}`))
+	// a deploy changes the version without a new revision
+	impl.setDeployedBackend("web", models.DeploymentBackendDocker)
+
+	if rw := rollback(impl, 9); rw.Code != http.StatusNotFound {
+		t.Errorf("expected a missing revision to be 404, got %d", rw.Code)
+	}
+	if rw := rollback(impl, 1); rw.Code != http.StatusOK {
+		t.Fatalf("expected the rollback to succeed, got %d: %s", rw.Code, rw.Body.String())
+	}
+	expected := []string{"1 created", "2 updated", "4 recorded before rolling back, it had no revision", "5 rolled back to revision 1"}
+	if revisions := revisionNumbers(t, impl.store, "web"); fmt.Sprint(revisions) != fmt.Sprint(expected) {
+		t.Errorf("got revisions %v, expected %v", revisions, expected)
+	}
+	svc, _ := impl.store.Get("web")
+	if svc.ResourceVersion != 5 || svc.Services[0].Replicas != 1 || svc.DeployedBackend != models.DeploymentBackendDocker {
+		t.Errorf("unexpected service after the rollback: %v", svc)
+	}
+
+	// rolling back again doesn't record the spec twice
+	if rw := rollback(impl, 2); rw.Code != http.StatusOK {
+		t.Fatalf("expected the rollback to succeed, got %d", rw.Code)
+	}
+	if revisions := revisionNumbers(t, impl.store, "web"); len(revisions) != 5 {
+		t.Errorf("unexpected revisions %v", revisions)
+	}
+}
@@ -0,0 +1,37 @@
+package restapi
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/metaparticle-io/metaparticle-ast/restapi/operations/services"
+)
+
+// HandleStatus implements the GetServiceStatusHandler interface
+func (i *Impl) HandleStatus(params services.GetServiceStatusParams) middleware.Responder {
+	if !i.auth.Allowed(params.HTTPRequest, RoleReader, params.Name) {
+		return services.NewGetServiceStatusDefault(http.StatusForbidden).WithPayload(forbidden(params.HTTPRequest, RoleReader, params.Name))
+	}
+	i.Lock()
+	svc, err := i.store.Get(params.Name)
+	i.Unlock()
+	if err != nil {
+		return services.NewGetServiceStatusDefault(http.StatusInternalServerError).WithPayload(apiError(http.StatusInternalServerError, err.Error()))
+	}
+	if svc == nil {
+		return services.NewGetServiceStatusNotFound().WithPayload(apiError(http.StatusNotFound, "service "+params.Name+" not found"))
+	}
+	backend := defaultBackend
+	if params.Backend != nil {
+		backend = *params.Backend
+	}
+	cmp, err := i.compilerFor(backend)
+	if err != nil {
+		return services.NewGetServiceStatusDefault(http.StatusInternalServerError).WithPayload(apiError(http.StatusInternalServerError, err.Error()))
+	}
+	status, err := cmp.Status(svc)
+	if err != nil {
+		return services.NewGetServiceStatusDefault(http.StatusBadGateway).WithPayload(apiError(http.StatusBadGateway, err.Error()))
+	}
+	return services.NewGetServiceStatusOK().WithPayload(status)
+}
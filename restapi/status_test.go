@@ -0,0 +1,33 @@
+package restapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/metaparticle-io/metaparticle-ast/restapi/operations/services"
+)
+
+func TestHandleStatus(t *testing.T) {
+	impl := newTestImpl(t, nil)
+	impl.store.Put("web", parseSpec(t, `{"name": "web"}`))
+	tests := []struct {
+		name    string
+		backend *string
+		code    int
+	}{
+		{name: "missing", code: http.StatusNotFound},
+		{name: "web", backend: swag.String("mesos"), code: http.StatusInternalServerError},
+	}
+	for _, test := range tests {
+		rw := respond(impl.HandleStatus(services.GetServiceStatusParams{
+			HTTPRequest: httptest.NewRequest(http.MethodGet, "/services/"+test.name+"/status", nil),
+			Name:        test.name,
+			Backend:     test.backend,
+		}))
+		if rw.Code != test.code {
+			t.Errorf("%s: expected %d, got %d: %s", test.name, test.code, rw.Code, rw.Body.String())
+		}
+	}
+}
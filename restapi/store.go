@@ -0,0 +1,134 @@
+package restapi
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/metaparticle-io/metaparticle-ast/models"
+)
+
+// Store persists the services known to the server.
+type Store interface {
+	// List returns every stored service.
+	List() ([]*models.Service, error)
+	// Get returns the named service, or nil if it doesn't exist.
+	Get(name string) (*models.Service, error)
+	// Put creates or replaces the named service, setting its resource
+	// version to the next version of the store.
+	Put(name string, svc *models.Service) error
+	// Delete removes the named service and returns it with the resource
+	// version of the deletion, or nil if it didn't exist. Its revisions are
+	// kept.
+	Delete(name string) (*models.Service, error)
+	// Version returns the resource version of the last change to the store.
+	Version() (int64, error)
+	// AddRevision records an accepted version of the named service.
+	AddRevision(name string, rev *models.Revision) error
+	// Revisions returns the revisions of the named service, oldest first.
+	Revisions(name string) ([]*models.Revision, error)
+	// Revision returns a revision of the named service, or nil if it doesn't exist.
+	Revision(name string, revision int64) (*models.Revision, error)
+}
+
+// StoreOptions are the command line flags that select the store.
+type StoreOptions struct {
+	Store    string `long:"store" description:"where to keep services" choice:"memory" choice:"file" default:"memory"`
+	StoreDir string `long:"store-dir" description:"the directory of the file store" default:"services"`
+}
+
+// NewStore creates the store selected by opts.
+func NewStore(opts *StoreOptions) (Store, error) {
+	switch opts.Store {
+	case "", "memory":
+		return NewMemoryStore(), nil
+	case "file":
+		return NewFileStore(opts.StoreDir)
+	default:
+		return nil, fmt.Errorf("unknown store: %s", opts.Store)
+	}
+}
+
+type memoryStore struct {
+	sync.Mutex
+	services  map[string]*models.Service
+	revisions map[string][]*models.Revision
+	version   int64
+}
+
+// NewMemoryStore creates a store that loses its services when the server exits.
+func NewMemoryStore() Store {
+	return &memoryStore{
+		services:  map[string]*models.Service{},
+		revisions: map[string][]*models.Revision{},
+	}
+}
+
+func (m *memoryStore) List() ([]*models.Service, error) {
+	m.Lock()
+	defer m.Unlock()
+	result := []*models.Service{}
+	for _, value := range m.services {
+		result = append(result, value)
+	}
+	return result, nil
+}
+
+func (m *memoryStore) Get(name string) (*models.Service, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.services[name], nil
+}
+
+func (m *memoryStore) Put(name string, svc *models.Service) error {
+	m.Lock()
+	defer m.Unlock()
+	m.version++
+	svc.ResourceVersion = m.version
+	m.services[name] = svc
+	return nil
+}
+
+func (m *memoryStore) Delete(name string) (*models.Service, error) {
+	m.Lock()
+	defer m.Unlock()
+	svc, found := m.services[name]
+	if !found {
+		return nil, nil
+	}
+	delete(m.services, name)
+	m.version++
+	// the stored service is shared with its revision
+	deleted := *svc
+	deleted.ResourceVersion = m.version
+	return &deleted, nil
+}
+
+func (m *memoryStore) Version() (int64, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.version, nil
+}
+
+func (m *memoryStore) AddRevision(name string, rev *models.Revision) error {
+	m.Lock()
+	defer m.Unlock()
+	m.revisions[name] = append(m.revisions[name], rev)
+	return nil
+}
+
+func (m *memoryStore) Revisions(name string) ([]*models.Revision, error) {
+	m.Lock()
+	defer m.Unlock()
+	return append([]*models.Revision{}, m.revisions[name]...), nil
+}
+
+func (m *memoryStore) Revision(name string, revision int64) (*models.Revision, error) {
+	m.Lock()
+	defer m.Unlock()
+	for _, rev := range m.revisions[name] {
+		if *rev.Revision == revision {
+			return rev, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,203 @@
+package restapi
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+)
+
+func newService(name string) *models.Service {
+	return &models.Service{Name: &name}
+}
+
+// storeNames returns the sorted names of the services of a store.
+func storeNames(t *testing.T, store Store) []string {
+	t.Helper()
+	services, err := store.List()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	names := []string{}
+	for _, svc := range services {
+		names = append(names, *svc.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestStores(t *testing.T) {
+	stores := map[string]func(t *testing.T) Store{
+		"memory": func(t *testing.T) Store {
+			return NewMemoryStore()
+		},
+		"file": func(t *testing.T) Store {
+			store, err := NewFileStore(t.TempDir())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			return store
+		},
+	}
+	for name, newStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			store := newStore(t)
+			for _, name := range []string{"web", "team/api", "web"} {
+				if err := store.Put(name, newService(name)); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			if names := storeNames(t, store); len(names) != 2 || names[0] != "team/api" || names[1] != "web" {
+				t.Errorf("unexpected services %v", names)
+			}
+			svc, err := store.Get("web")
+			if err != nil || svc == nil || svc.ResourceVersion != 3 {
+				t.Fatalf("expected web at version 3, got %v, %v", svc, err)
+			}
+			if missing, err := store.Get("missing"); missing != nil || err != nil {
+				t.Errorf("expected nothing for a missing service, got %v, %v", missing, err)
+			}
+
+			deleted, err := store.Delete("web")
+			if err != nil || deleted == nil || deleted.ResourceVersion != 4 {
+				t.Fatalf("expected web deleted at version 4, got %v, %v", deleted, err)
+			}
+			if deleted, err := store.Delete("web"); deleted != nil || err != nil {
+				t.Errorf("expected nothing for a second delete, got %v, %v", deleted, err)
+			}
+			if version, err := store.Version(); version != 4 || err != nil {
+				t.Errorf("expected version 4, got %d, %v", version, err)
+			}
+			if names := storeNames(t, store); len(names) != 1 || names[0] != "team/api" {
+				t.Errorf("unexpected services %v", names)
+			}
+		})
+	}
+}
+
+func TestFileStoreReopen(t *testing.T) {
+	tests := []struct {
+		name    string
+		prepare func(t *testing.T, dir string)
+		version int64
+		names   int
+	}{
+		{
+			name: "deleted newest service",
+			prepare: func(t *testing.T, dir string) {
+				store, _ := NewFileStore(dir)
+				store.Put("web", newService("web"))
+				store.Put("api", newService("api"))
+				store.Delete("api")
+			},
+			version: 3,
+			names:   1,
+		},
+		{
+			name: "store without a version file",
+			prepare: func(t *testing.T, dir string) {
+				store, _ := NewFileStore(dir)
+				store.Put("web", newService("web"))
+				store.Put("web", newService("web"))
+				os.Remove(path.Join(dir, versionFile))
+			},
+			version: 2,
+			names:   1,
+		},
+		{
+			name: "crash before a rename",
+			prepare: func(t *testing.T, dir string) {
+				store, _ := NewFileStore(dir)
+				store.Put("web", newService("web"))
+				// a write that never got renamed into place
+				ioutil.WriteFile(path.Join(dir, ".tmp-123"), []byte(`{"name": "torn`), 0600)
+			},
+			version: 1,
+			names:   1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			test.prepare(t, dir)
+			store, err := NewFileStore(dir)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if version, _ := store.Version(); version != test.version {
+				t.Errorf("expected version %d, got %d", test.version, version)
+			}
+			if names := storeNames(t, store); len(names) != test.names {
+				t.Errorf("expected %d services, got %v", test.names, names)
+			}
+			svc := newService("next")
+			if err := store.Put("next", svc); err != nil || svc.ResourceVersion != test.version+1 {
+				t.Errorf("expected the next version to be %d, got %d, %v", test.version+1, svc.ResourceVersion, err)
+			}
+		})
+	}
+}
+
+func TestFileStoreCorruptVersion(t *testing.T) {
+	dir := t.TempDir()
+	ioutil.WriteFile(path.Join(dir, versionFile), []byte("garbage"), 0600)
+	if _, err := NewFileStore(dir); err == nil {
+		t.Errorf("expected an error for a corrupt version file")
+	}
+}
+
+func TestFileStoreNames(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	names := []string{".", "..", "%2E", "a/b", "web"}
+	for ix, name := range names {
+		if err := store.Put(name, newService(name)); err != nil {
+			t.Fatalf("%q: unexpected error: %v", name, err)
+		}
+		rev := &models.Revision{Revision: swag.Int64(int64(ix + 1)), Service: newService(name)}
+		if err := store.AddRevision(name, rev); err != nil {
+			t.Fatalf("%q: unexpected error: %v", name, err)
+		}
+	}
+	if listed := storeNames(t, store); !reflect.DeepEqual(listed, []string{"%2E", ".", "..", "a/b", "web"}) {
+		t.Errorf("unexpected services %v", listed)
+	}
+	for ix, name := range names {
+		revisions, err := store.Revisions(name)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", name, err)
+		}
+		if len(revisions) != 1 || *revisions[0].Revision != int64(ix+1) {
+			t.Errorf("%q: expected only revision %d, got %v", name, ix+1, revisions)
+		}
+	}
+	// every service keeps its files inside the store, and its revisions in a
+	// directory of their own
+	entries, err := ioutil.ReadDir(path.Join(dir, revisionsDir))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			t.Errorf("unexpected file %s among the revisions", entry.Name())
+		}
+	}
+	if len(entries) != len(names) {
+		t.Errorf("expected a directory for each service, got %d", len(entries))
+	}
+
+	if err := store.Put("", newService("")); err == nil {
+		t.Errorf("expected a service without a name to be refused")
+	}
+	if err := store.AddRevision("", &models.Revision{Revision: swag.Int64(1)}); err == nil {
+		t.Errorf("expected a revision without a service name to be refused")
+	}
+}
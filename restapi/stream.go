@@ -0,0 +1,58 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	runtime "github.com/go-openapi/runtime"
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+const eventStream = "text/event-stream"
+
+// streamFormats are the media types that logs and watches are streamed as.
+// JSON and NDJSON both write one object per line, like a Kubernetes watch.
+var streamFormats = []string{runtime.JSONMime, "application/x-ndjson", eventStream}
+
+// streamWriter writes the objects of a stream and sends each one at once.
+type streamWriter struct {
+	sync.Mutex
+	rw     http.ResponseWriter
+	format string
+}
+
+// newStreamWriter starts a stream in the format the request accepts.
+func newStreamWriter(rw http.ResponseWriter, r *http.Request) *streamWriter {
+	w := &streamWriter{
+		rw:     rw,
+		format: middleware.NegotiateContentType(r, streamFormats, streamFormats[0]),
+	}
+	rw.Header().Set(runtime.HeaderContentType, w.format)
+	rw.WriteHeader(http.StatusOK)
+	w.flush()
+	return w
+}
+
+// write sends an object, event names the server-sent event.
+func (w *streamWriter) write(event string, value interface{}) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+	w.Lock()
+	defer w.Unlock()
+	if w.format == eventStream {
+		fmt.Fprintf(w.rw, "event: %s\ndata: %s\n\n", event, data)
+	} else {
+		fmt.Fprintf(w.rw, "%s\n", data)
+	}
+	w.flush()
+}
+
+func (w *streamWriter) flush() {
+	if flusher, ok := w.rw.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,40 @@
+package restapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStreamWriter(t *testing.T) {
+	tests := []struct {
+		accept      string
+		contentType string
+		body        string
+	}{
+		{accept: "", contentType: "application/json", body: "{\"message\":\"one\"}\n{\"message\":\"two\"}\n"},
+		{accept: "application/x-ndjson", contentType: "application/x-ndjson", body: "{\"message\":\"one\"}\n{\"message\":\"two\"}\n"},
+		{
+			accept:      "text/event-stream",
+			contentType: "text/event-stream",
+			body:        "event: log\ndata: {\"message\":\"one\"}\n\nevent: log\ndata: {\"message\":\"two\"}\n\n",
+		},
+	}
+	for _, test := range tests {
+		r := httptest.NewRequest(http.MethodGet, "/services/web/logs", nil)
+		if len(test.accept) > 0 {
+			r.Header.Set("Accept", test.accept)
+		}
+		rw := httptest.NewRecorder()
+		w := newStreamWriter(rw, r)
+		for _, message := range []string{"one", "two"} {
+			w.write("log", map[string]string{"message": message})
+		}
+		if contentType := rw.Header().Get("Content-Type"); contentType != test.contentType {
+			t.Errorf("%q: content type %s, expected %s", test.accept, contentType, test.contentType)
+		}
+		if !rw.Flushed || rw.Body.String() != test.body {
+			t.Errorf("%q: got %q, expected %q", test.accept, rw.Body.String(), test.body)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package restapi
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	runtime "github.com/go-openapi/runtime"
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+	"github.com/metaparticle-io/metaparticle-ast/restapi/operations/services"
+)
+
+const (
+	// watchHistory is the number of changes kept for watches that resume
+	// from a resource version.
+	watchHistory = 1000
+	// watchBuffer is the number of changes a watch can fall behind before
+	// it is ended.
+	watchBuffer = 100
+)
+
+// watcher receives the changes to the store for a watch.
+type watcher struct {
+	events chan *models.WatchEvent
+}
+
+// publish records a change to the store and sends it to the watchers. It is
+// called with the lock held, so changes are sent in the order of their
+// resource versions.
+func (i *Impl) publish(eventType string, svc *models.Service) {
+	event := &models.WatchEvent{
+		Type:    swag.String(eventType),
+		Service: svc,
+	}
+	i.history = append(i.history, event)
+	if len(i.history) > watchHistory {
+		i.historySince = i.history[0].Service.ResourceVersion
+		i.history = i.history[1:]
+	}
+	for w := range i.watchers {
+		select {
+		case w.events <- event:
+		default:
+			// the client has to list and watch again
+			delete(i.watchers, w)
+			close(w.events)
+		}
+	}
+}
+
+func (i *Impl) unwatch(w *watcher) {
+	i.Lock()
+	defer i.Unlock()
+	if i.watchers[w] {
+		delete(i.watchers, w)
+		close(w.events)
+	}
+}
+
+// watch streams the changes to the store after a resource version or, when
+// there is none, every stored service followed by the changes.
+func (i *Impl) watch(params services.ListServicesParams, filter *serviceFilter) middleware.Responder {
+	visible := func(svc *models.Service) bool {
+		return i.auth.Allowed(params.HTTPRequest, RoleReader, *svc.Name) && filter.matches(svc)
+	}
+	i.Lock()
+	defer i.Unlock()
+	initial := []*models.WatchEvent{}
+	if params.ResourceVersion == nil {
+		stored, err := i.store.List()
+		if err != nil {
+			return services.NewListServicesDefault(http.StatusInternalServerError).WithPayload(apiError(http.StatusInternalServerError, err.Error()))
+		}
+		sort.Slice(stored, func(a, b int) bool {
+			return *stored[a].Name < *stored[b].Name
+		})
+		for _, svc := range stored {
+			if !visible(svc) {
+				continue
+			}
+			initial = append(initial, &models.WatchEvent{
+				Type:    swag.String(models.WatchEventTypeADDED),
+				Service: svc,
+			})
+		}
+	} else {
+		since := *params.ResourceVersion
+		version, err := i.store.Version()
+		if err != nil {
+			return services.NewListServicesDefault(http.StatusInternalServerError).WithPayload(apiError(http.StatusInternalServerError, err.Error()))
+		}
+		// versions after the current one come from before a memory store restarted
+		if since < i.historySince || since > version {
+			message := fmt.Sprintf("the changes after resource version %d are no longer known", since)
+			return services.NewListServicesGone().WithPayload(apiError(http.StatusGone, message))
+		}
+		for _, event := range i.history {
+			if event.Service.ResourceVersion > since && visible(event.Service) {
+				initial = append(initial, event)
+			}
+		}
+	}
+	w := &watcher{events: make(chan *models.WatchEvent, watchBuffer)}
+	i.watchers[w] = true
+
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		defer i.unwatch(w)
+		stream := newStreamWriter(rw, params.HTTPRequest)
+		for _, event := range initial {
+			stream.write(*event.Type, event)
+		}
+		// the stream stops when the client goes away
+		done := params.HTTPRequest.Context().Done()
+		for {
+			select {
+			case event, ok := <-w.events:
+				if !ok {
+					return
+				}
+				if visible(event.Service) {
+					stream.write(*event.Type, event)
+				}
+			case <-done:
+				return
+			}
+		}
+	})
+}
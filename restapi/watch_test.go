@@ -0,0 +1,99 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/metaparticle-io/metaparticle-ast/models"
+	"github.com/metaparticle-io/metaparticle-ast/restapi/operations/services"
+)
+
+// endWatches closes the streams of every watch, they end once they sent
+// the changes they have received.
+func endWatches(impl *Impl) {
+	impl.Lock()
+	defer impl.Unlock()
+	for w := range impl.watchers {
+		delete(impl.watchers, w)
+		close(w.events)
+	}
+}
+
+func TestWatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  *int64
+		prefix   *string
+		code     int
+		expected []string
+	}{
+		{
+			name:     "from the start",
+			code:     http.StatusOK,
+			expected: []string{"ADDED api 3", "ADDED web 1", "ADDED worker 4", "DELETED web 5"},
+		},
+		{
+			name:     "from a resource version",
+			version:  swag.Int64(2),
+			code:     http.StatusOK,
+			expected: []string{"MODIFIED api 3", "ADDED worker 4", "DELETED web 5"},
+		},
+		{
+			name:     "with a prefix",
+			version:  swag.Int64(0),
+			prefix:   swag.String("w"),
+			code:     http.StatusOK,
+			expected: []string{"ADDED web 1", "ADDED worker 4", "DELETED web 5"},
+		},
+		{name: "from the future", version: swag.Int64(10), code: http.StatusGone},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			impl := newTestImpl(t, nil)
+			put(t, impl, "web", `{"name": "web"}`, "")
+			put(t, impl, "api", `{"name": "api"}`, "")
+			put(t, impl, "api", `{"name": "api", "labels": {"tier": "backend"}}`, "")
+
+			responder := impl.HandleListServices(services.ListServicesParams{
+				HTTPRequest:     httptest.NewRequest(http.MethodGet, "/services?watch=true", nil),
+				Watch:           swag.Bool(true),
+				ResourceVersion: test.version,
+				Prefix:          test.prefix,
+			})
+			responses := make(chan *httptest.ResponseRecorder)
+			go func() {
+				responses <- respond(responder)
+			}()
+			put(t, impl, "worker", `{"name": "worker"}`, "")
+			respond(impl.HandleDestroyOne(services.DeleteServiceParams{
+				HTTPRequest: httptest.NewRequest(http.MethodDelete, "/services/web", nil),
+				Name:        "web",
+			}))
+			endWatches(impl)
+
+			rw := <-responses
+			if rw.Code != test.code {
+				t.Fatalf("expected %d, got %d: %s", test.code, rw.Code, rw.Body.String())
+			}
+			if test.code != http.StatusOK {
+				return
+			}
+			events := []string{}
+			for _, line := range strings.Split(strings.TrimSpace(rw.Body.String()), "\n") {
+				event := &models.WatchEvent{}
+				if err := json.Unmarshal([]byte(line), event); err != nil {
+					t.Fatalf("invalid event %q: %v", line, err)
+				}
+				events = append(events, fmt.Sprintf("%s %s %d", *event.Type, *event.Service.Name, event.Service.ResourceVersion))
+			}
+			if strings.Join(events, "|") != strings.Join(test.expected, "|") {
+				t.Errorf("got events %v, expected %v", events, test.expected)
+			}
+		})
+	}
+}